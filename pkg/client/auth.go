@@ -62,3 +62,31 @@ func (c *Client) InvalidateToken() errors.Error {
 
 	return nil
 }
+
+// GetProfile returns the user the client is authenticated as.
+func (c *Client) GetProfile() (*model.UserEntity, errors.Error) {
+	response := &model.UserEntity{}
+	if err := c.Request(
+		WithMethod(http.MethodGet),
+		WithPath("/auth/profile"),
+		WithResponseInto(response),
+	); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetAccount returns the account of the user the client is authenticated as.
+func (c *Client) GetAccount() (*model.AccountEntity, errors.Error) {
+	response := &model.AccountEntity{}
+	if err := c.Request(
+		WithMethod(http.MethodGet),
+		WithPath("/api/account"),
+		WithResponseInto(response),
+	); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
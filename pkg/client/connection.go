@@ -0,0 +1,84 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/model"
+)
+
+// ConnectionAuthTypeSecret is the authentication type of connections created
+// from fields such as keys and tokens, rather than through OAuth.
+const ConnectionAuthTypeSecret = "secret"
+
+type ListConnectionsResponse struct {
+	Connections []*model.Connection `json:"connections"`
+}
+
+func (c *Client) ListConnections() (*ListConnectionsResponse, errors.Error) {
+	resp := &ListConnectionsResponse{}
+
+	if err := c.Request(
+		WithPath("/api/connections"),
+		WithResponseInto(resp),
+	); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type CreateConnectionAuthParameters struct {
+	Type   string            `json:"type"`
+	Fields map[string]string `json:"fields"`
+}
+
+type CreateConnectionParameters struct {
+	Name string                          `json:"name"`
+	Type string                          `json:"type"`
+	Auth *CreateConnectionAuthParameters `json:"auth"`
+}
+
+func (c *Client) CreateConnection(typ, name string, fields map[string]string) (*model.ConnectionEntity, errors.Error) {
+	params := &CreateConnectionParameters{
+		Name: name,
+		Type: typ,
+		Auth: &CreateConnectionAuthParameters{
+			Type:   ConnectionAuthTypeSecret,
+			Fields: fields,
+		},
+	}
+
+	response := &model.ConnectionEntity{}
+
+	if err := c.Request(
+		WithMethod(http.MethodPost),
+		WithPath("/api/connections"),
+		WithBody(params),
+		WithResponseInto(response),
+	); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+type DeleteConnectionResponse struct {
+	Success    bool   `json:"success"`
+	ResourceId string `json:"resource_id"`
+}
+
+func (c *Client) DeleteConnection(id string) (*DeleteConnectionResponse, errors.Error) {
+	response := &DeleteConnectionResponse{}
+
+	if err := c.Request(
+		WithMethod(http.MethodDelete),
+		WithPath(fmt.Sprintf("/api/connections/%v", id)),
+		WithResponseInto(response),
+	); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/model"
+)
+
+type CreateEventParameters struct {
+	Data map[string]interface{} `json:"data"`
+	Key  string                 `json:"key,omitempty"`
+}
+
+// CreateEvent sends an event to the push trigger that the given push token
+// belongs to.
+func (c *Client) CreateEvent(token string, data map[string]interface{}, key string) (*model.EventEntity, errors.Error) {
+	params := &CreateEventParameters{
+		Data: data,
+		Key:  key,
+	}
+
+	response := &model.EventEntity{}
+
+	if err := c.Request(
+		WithMethod(http.MethodPost),
+		WithPath("/api/events"),
+		WithHeaders(map[string]string{
+			"Authorization": "Bearer " + token,
+		}),
+		WithBody(params),
+		WithResponseInto(response),
+	); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
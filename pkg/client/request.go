@@ -18,10 +18,12 @@ import (
 type RequestOptions struct {
 	method           string
 	path             string
+	query            url.Values
 	headers          map[string]string
 	BodyEncodingType BodyEncodingType
 	body             interface{}
 	responseBody     interface{}
+	responseWriter   io.Writer
 }
 
 type RequestOptionSetter func(*RequestOptions)
@@ -38,6 +40,12 @@ func WithPath(path string) RequestOptionSetter {
 	}
 }
 
+func WithQuery(query url.Values) RequestOptionSetter {
+	return func(opts *RequestOptions) {
+		opts.query = query
+	}
+}
+
 func WithHeaders(headers map[string]string) RequestOptionSetter {
 	return func(opts *RequestOptions) {
 		opts.headers = headers
@@ -62,6 +70,14 @@ func WithResponseInto(responseBody interface{}) RequestOptionSetter {
 	}
 }
 
+// WithResponseWriter streams the raw response body to the given writer as it
+// is received instead of decoding it.
+func WithResponseWriter(w io.Writer) RequestOptionSetter {
+	return func(opts *RequestOptions) {
+		opts.responseWriter = w
+	}
+}
+
 type BodyEncoding interface {
 	ContentType() string
 	Encode(interface{}) (io.ReadWriter, errors.Error)
@@ -145,7 +161,7 @@ func (c *Client) Request(setters ...RequestOptionSetter) errors.Error {
 			WithCause(errors.NewConfigInvalidAPIDomain(""))
 	}
 
-	rel := &url.URL{Path: opts.path}
+	rel := &url.URL{Path: opts.path, RawQuery: opts.query.Encode()}
 	u := contextConfig.Domains.APIDomain.ResolveReference(rel)
 
 	encoding, ok := mapEncodingTypeToEncoding[opts.BodyEncodingType]
@@ -201,7 +217,7 @@ func (c *Client) Request(setters ...RequestOptionSetter) errors.Error {
 	}
 
 	// temporary but very useful debugging solution until we get real logging in place
-	debug.LogDump(httputil.DumpResponse(resp, true))
+	debug.LogDump(httputil.DumpResponse(resp, opts.responseWriter == nil))
 
 	defer resp.Body.Close()
 
@@ -209,6 +225,12 @@ func (c *Client) Request(setters ...RequestOptionSetter) errors.Error {
 		return parseError(resp)
 	}
 
+	if resp.Body != nil && opts.responseWriter != nil {
+		if _, err := io.Copy(opts.responseWriter, resp.Body); err != nil {
+			return errors.NewClientRequestError().WithCause(err)
+		}
+	}
+
 	if resp.Body != nil && opts.responseBody != nil {
 		jerr := json.NewDecoder(resp.Body).Decode(opts.responseBody)
 
@@ -247,10 +269,11 @@ func parseError(resp *http.Response) errors.Error {
 	// Attempt to parse relay api error envelope containing an errawr
 	var cause errors.Error
 	env := &errorEnvelope{}
-	if err := json.Unmarshal(bytes, env); err == nil {
+	if err := json.Unmarshal(bytes, env); err == nil && env.Error != nil {
 		cause = env.Error.AsError()
 	} else {
 		cause = errors.NewClientBadRequestBody(string(bytes))
+		env = nil
 	}
 
 	// otherwise return generic errors based on response code
@@ -263,5 +286,9 @@ func parseError(resp *http.Response) errors.Error {
 		return errors.NewClientUserNotAuthorized().WithCause(cause)
 	}
 
+	if env != nil && resp.StatusCode >= 400 && resp.StatusCode < 500 {
+		return errors.NewClientRequestRejected().WithCause(cause)
+	}
+
 	return errors.NewClientRequestError().WithCause(cause)
 }
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"sort"
 
 	"github.com/puppetlabs/relay/pkg/errors"
 	"github.com/puppetlabs/relay/pkg/model"
@@ -77,3 +78,68 @@ func (c *Client) GetLatestRevision(workflowName string) (*model.RevisionEntity,
 
 	return c.GetRevision(workflowName, wf.Workflow.LatestRevision.ID)
 }
+
+// ListWorkflowRevisions gets the revisions of a workflow that are known to the
+// service, newest first, and the ID of the latest revision. The API has no
+// revision listing, so this returns the latest revision along with every
+// revision used by a run of the workflow.
+func (c *Client) ListWorkflowRevisions(workflowName string) ([]*model.Revision, string, errors.Error) {
+	wf, err := c.GetWorkflow(workflowName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var ids []string
+	seen := make(map[string]bool)
+
+	add := func(rev *model.RevisionSummary) {
+		if rev == nil || rev.RevisionIdentifier == nil || rev.ID == "" || seen[rev.ID] {
+			return
+		}
+
+		seen[rev.ID] = true
+		ids = append(ids, rev.ID)
+	}
+
+	add(wf.Workflow.LatestRevision)
+
+	var latest string
+	if len(ids) > 0 {
+		latest = ids[0]
+	}
+
+	runs, err := c.ListWorkflowRuns(workflowName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for _, run := range runs.Runs {
+		add(&run.Revision)
+	}
+
+	revs := make([]*model.Revision, 0, len(ids))
+
+	for _, id := range ids {
+		rev, err := c.GetRevision(workflowName, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		revs = append(revs, rev.Revision)
+	}
+
+	sort.SliceStable(revs, func(i, j int) bool {
+		a, b := revs[i].CreatedAT, revs[j].CreatedAT
+
+		switch {
+		case a == nil:
+			return false
+		case b == nil:
+			return true
+		}
+
+		return a.After(*b)
+	})
+
+	return revs, latest, nil
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/model"
+)
+
+const (
+	RunStatusWaiting   = "waiting"
+	RunStatusSuccess   = "success"
+	RunStatusFailure   = "failure"
+	RunStatusCancelled = "cancelled"
+	RunStatusTimedOut  = "timed-out"
+	RunStatusSkipped   = "skipped"
+)
+
+// IsTerminalRunStatus returns true if a run or step with the given status will
+// not make any further progress.
+func IsTerminalRunStatus(status string) bool {
+	switch status {
+	case RunStatusSuccess, RunStatusFailure, RunStatusCancelled, RunStatusTimedOut, RunStatusSkipped:
+		return true
+	}
+
+	return false
+}
+
+type ListWorkflowRunsResponse struct {
+	Runs []*model.WorkflowRun `json:"runs"`
+}
+
+func (c *Client) ListWorkflowRuns(name string) (*ListWorkflowRunsResponse, errors.Error) {
+	resp := &ListWorkflowRunsResponse{}
+
+	if err := c.Request(
+		WithPath(path.Join("/api/workflows", url.PathEscape(name), "runs")),
+		WithResponseInto(resp),
+	); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c *Client) GetWorkflowRun(name string, runNumber int) (*model.WorkflowRunEntity, errors.Error) {
+	resp := &model.WorkflowRunEntity{}
+
+	if err := c.Request(
+		WithPath(path.Join("/api/workflows", url.PathEscape(name), "runs", fmt.Sprintf("%d", runNumber))),
+		WithResponseInto(resp),
+	); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetWorkflowRunStepLog writes the log of a single step to the given writer.
+// If follow is set, the log is streamed until the step completes.
+func (c *Client) GetWorkflowRunStepLog(name string, runNumber int, step string, follow bool, w io.Writer) errors.Error {
+	query := url.Values{}
+	if follow {
+		query.Set("follow", "true")
+	}
+
+	return c.Request(
+		WithPath(path.Join("/api/workflows", url.PathEscape(name), "runs", fmt.Sprintf("%d", runNumber), "steps", url.PathEscape(step), "logs")),
+		WithQuery(query),
+		WithHeaders(map[string]string{
+			"Accept": "application/octet-stream",
+		}),
+		WithResponseWriter(w),
+	)
+}
+
+type UpdateWorkflowRunOperationRequest struct {
+	Cancel bool `json:"cancel,omitempty"`
+}
+
+type UpdateWorkflowRunRequest struct {
+	Operation *UpdateWorkflowRunOperationRequest `json:"operation,omitempty"`
+}
+
+func (c *Client) CancelWorkflowRun(name string, runNumber int) (*model.WorkflowRunEntity, errors.Error) {
+	req := &UpdateWorkflowRunRequest{
+		Operation: &UpdateWorkflowRunOperationRequest{Cancel: true},
+	}
+
+	resp := &model.WorkflowRunEntity{}
+
+	if err := c.Request(
+		WithMethod(http.MethodPatch),
+		WithPath(path.Join("/api/workflows", url.PathEscape(name), "runs", fmt.Sprintf("%d", runNumber))),
+		WithBody(req),
+		WithResponseInto(resp),
+	); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+const (
+	StepApprovalWaiting  = "waiting"
+	StepApprovalApproved = "approved"
+	StepApprovalRejected = "rejected"
+)
+
+type UpdateWorkflowRunStepRequest struct {
+	Approval string `json:"approval"`
+}
+
+// SetWorkflowRunStepApproval submits an approval decision for an approval step
+// that is waiting on user input.
+func (c *Client) SetWorkflowRunStepApproval(name string, runNumber int, step, approval string) (*model.WorkflowRunStepState, errors.Error) {
+	req := &UpdateWorkflowRunStepRequest{
+		Approval: approval,
+	}
+
+	resp := &model.WorkflowRunStepState{}
+
+	if err := c.Request(
+		WithMethod(http.MethodPatch),
+		WithPath(path.Join("/api/workflows", url.PathEscape(name), "runs", fmt.Sprintf("%d", runNumber), "steps", url.PathEscape(step))),
+		WithBody(req),
+		WithResponseInto(resp),
+	); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -1,7 +1,6 @@
 package client
 
 import (
-	"github.com/puppetlabs/relay/pkg/config"
 	"github.com/puppetlabs/relay/pkg/model"
 )
 
@@ -12,14 +11,9 @@ func (c *Client) getToken() (*model.Token, error) {
 			context := c.config.CurrentContext
 
 			if contextConfig, ok := c.config.ContextConfig[context]; ok {
-				if contextConfig.Auth != nil && contextConfig.Auth.Tokens != nil {
-					for _, tokenType := range config.AuthTokenTypes() {
-						if value, ok := contextConfig.Auth.Tokens[tokenType]; ok && value != "" {
-							token := model.Token(value)
-							c.loadedToken = &token
-							return c.loadedToken, nil
-						}
-					}
+				if _, value, ok := contextConfig.Auth.Token(); ok {
+					token := model.Token(value)
+					c.loadedToken = &token
 				}
 			}
 		}
@@ -1,7 +1,6 @@
 package client
 
 import (
-	"encoding/base64"
 	"fmt"
 	"net/http"
 	"time"
@@ -153,7 +152,7 @@ func (c *Client) DeleteWorkflow(name string) (*DeleteWorkflowResponse, errors.Er
 }
 
 type RunWorkflowParameterValueRequest struct {
-	Value string `json:"value"`
+	Value interface{} `json:"value"`
 }
 
 type RunWorkflowRequest struct {
@@ -165,7 +164,7 @@ type RunWorkflowWorkflowResponse struct {
 }
 
 type RunWorkflowParameterValueResponse struct {
-	Value string `json:"value"`
+	Value interface{} `json:"value"`
 }
 
 type RunWorkflowRevisionResponse struct {
@@ -193,7 +192,7 @@ type RunWorkflowResponse struct {
 	Run RunWorkflowRunResponse `json:"run"`
 }
 
-func setupParams(params map[string]string) map[string]RunWorkflowParameterValueRequest {
+func setupParams(params map[string]interface{}) map[string]RunWorkflowParameterValueRequest {
 	res := make(map[string]RunWorkflowParameterValueRequest, len(params))
 
 	for key, val := range params {
@@ -203,7 +202,7 @@ func setupParams(params map[string]string) map[string]RunWorkflowParameterValueR
 	return res
 }
 
-func (c *Client) RunWorkflow(name string, params map[string]string) (*RunWorkflowResponse, errors.Error) {
+func (c *Client) RunWorkflow(name string, params map[string]interface{}) (*RunWorkflowResponse, errors.Error) {
 	req := &RunWorkflowRequest{
 		Parameters: setupParams(params),
 	}
@@ -230,12 +229,12 @@ func (c *Client) DownloadWorkflow(name string) (string, errors.Error) {
 		return "", err
 	}
 
-	dec, berr := base64.StdEncoding.DecodeString(rev.Revision.Raw)
+	raw, berr := rev.Revision.DecodeRaw()
 
 	if berr != nil {
 		debug.Logf("the workflow body was in the wrong format. %s", berr.Error())
 		return "", errors.NewClientUnknownError().WithCause(berr)
 	}
 
-	return string(dec), nil
+	return raw, nil
 }
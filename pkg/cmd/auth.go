@@ -25,17 +25,20 @@ func newAuthCommand() *cobra.Command {
 
 	cmd.AddCommand(newLoginCommand())
 	cmd.AddCommand(newLogoutCommand())
+	cmd.AddCommand(newAuthStatusCommand())
+	cmd.AddCommand(newAuthListCommand())
+	cmd.AddCommand(newAuthUseCommand())
 
 	return cmd
 }
 
-func negotiateSession(cmd *cobra.Command) error {
+func negotiateSession(cmd *cobra.Command, credential string) error {
 	deviceValues, cterr := Client.CreateToken()
 	if cterr != nil {
 		return cterr
 	}
 
-	writeAuthTokenConfig(cmd, deviceValues.Token.String(), config.AuthTokenTypeSession)
+	writeAuthTokenConfig(cmd, credential, deviceValues.Token.String(), config.AuthTokenTypeSession)
 
 	Dialog.Info("Stored authorization token.")
 
@@ -76,7 +79,7 @@ Press [ENTER] to open %s in a browser or any other key to cancel...`,
 	return nil
 }
 
-func readAuthFromStdin(cmd *cobra.Command) error {
+func readAuthFromStdin(cmd *cobra.Command, credential string) error {
 	gotStdin, err := util.PassedStdin()
 	if err != nil {
 		return err
@@ -88,12 +91,23 @@ func readAuthFromStdin(cmd *cobra.Command) error {
 			return err
 		}
 
-		writeAuthTokenConfig(cmd, string(token), config.AuthTokenTypeAPI)
+		writeAuthTokenConfig(cmd, credential, string(token), config.AuthTokenTypeAPI)
 	}
 
 	return nil
 }
 func doLogin(cmd *cobra.Command, args []string) error {
+	credential, err := cmd.Flags().GetString("name")
+	if err != nil {
+		return err
+	}
+
+	if credential == "" {
+		credential = currentAuthConfig().CredentialName()
+	} else if !config.IsValidAuthCredentialName(credential) {
+		return errors.NewAuthInvalidCredentialNameError(credential)
+	}
+
 	Dialog.Progress("Getting authorization...")
 
 	stdin, err := cmd.Flags().GetBool("stdin")
@@ -102,7 +116,7 @@ func doLogin(cmd *cobra.Command, args []string) error {
 	}
 
 	if stdin {
-		err = readAuthFromStdin(cmd)
+		err = readAuthFromStdin(cmd, credential)
 		if err != nil {
 			return err
 		}
@@ -121,12 +135,12 @@ func doLogin(cmd *cobra.Command, args []string) error {
 			return nil
 		}
 
-		writeAuthTokenConfig(cmd, string(token), config.AuthTokenTypeAPI)
+		writeAuthTokenConfig(cmd, credential, string(token), config.AuthTokenTypeAPI)
 
 		return nil
 	}
 
-	err = negotiateSession(cmd)
+	err = negotiateSession(cmd, credential)
 	if err != nil {
 		return err
 	}
@@ -139,12 +153,18 @@ func newLoginCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "login",
 		Short: "Log in to Relay",
-		Args:  cobra.MaximumNArgs(1),
-		RunE:  doLogin,
+		Long: `Log in to Relay.
+
+The token is stored as the credential in use for the current context, or as the
+credential given by --name, which then becomes the credential in use. Switch
+between the credentials of a context with relay auth use.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: doLogin,
 	}
 
 	cmd.Flags().StringP("file", "f", "", "Read authentication credentials from file")
 	cmd.Flags().Bool("stdin", false, "Read authentication credentials from stdin")
+	cmd.Flags().String("name", "", "Name of the credential to store the token as (default is the credential in use)")
 
 	return cmd
 }
@@ -173,12 +193,13 @@ func newLogoutCommand() *cobra.Command {
 	return cmd
 }
 
-func writeAuthTokenConfig(cmd *cobra.Command, token string, tokenType config.AuthTokenType) {
+func writeAuthTokenConfig(cmd *cobra.Command, credential, token string, tokenType config.AuthTokenType) {
 	if len(token) > 0 {
 		cfg := &config.Config{
 			ContextConfig: map[string]*config.ContextConfig{
 				Config.CurrentContext: {
 					Auth: &config.AuthConfig{
+						Credential: credential,
 						Tokens: map[config.AuthTokenType]string{
 							tokenType: strings.TrimSpace(token),
 						},
@@ -190,3 +211,13 @@ func writeAuthTokenConfig(cmd *cobra.Command, token string, tokenType config.Aut
 		config.WriteConfig(cfg, cmd.Flags())
 	}
 }
+
+// currentAuthConfig returns the authentication configuration of the current
+// context, which may be nil.
+func currentAuthConfig() *config.AuthConfig {
+	if cc, ok := Config.ContextConfig[Config.CurrentContext]; ok {
+		return cc.Auth
+	}
+
+	return nil
+}
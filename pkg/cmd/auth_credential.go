@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/puppetlabs/relay/pkg/config"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newAuthListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the credentials stored for the current context",
+		Args:  cobra.NoArgs,
+		RunE:  doAuthList,
+	}
+
+	return cmd
+}
+
+func doAuthList(cmd *cobra.Command, args []string) error {
+	auth := currentAuthConfig()
+	current := auth.CredentialName()
+
+	t := Dialog.Table()
+
+	t.Headers([]string{"Credential", "Token Types", "In Use"})
+
+	for _, name := range authCredentialNames(auth) {
+		var tokens map[config.AuthTokenType]string
+		if auth != nil {
+			tokens = auth.Credentials[name]
+		}
+
+		var types []string
+		for _, tokenType := range config.AuthTokenTypes() {
+			if tokens[tokenType] != "" {
+				types = append(types, tokenType.String())
+			}
+		}
+
+		if len(types) == 0 {
+			types = append(types, "none")
+		}
+
+		inUse := ""
+		if name == current {
+			inUse = "*"
+		}
+
+		t.AppendRow([]string{name, strings.Join(types, ", "), inUse})
+	}
+
+	t.Flush()
+
+	return nil
+}
+
+func newAuthUseCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "use [credential name]",
+		Short: "Choose the credential to use for the current context",
+		Long: `Choose the credential to use for the current context.
+
+Credentials are added with relay auth login --name. The credential stored by
+relay auth login without --name is called default.`,
+		Args: cobra.ExactArgs(1),
+		RunE: doAuthUse,
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return authCredentialNames(currentAuthConfig()), cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+
+	return cmd
+}
+
+func doAuthUse(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	context := Config.CurrentContext
+
+	if !config.IsValidAuthCredentialName(name) {
+		return errors.NewAuthInvalidCredentialNameError(name)
+	}
+
+	if auth := currentAuthConfig(); name != config.DefaultAuthCredential && (auth == nil || auth.Credentials[name] == nil) {
+		return errors.NewAuthUnknownCredentialError(context, name)
+	}
+
+	cfg := &config.Config{
+		ContextConfig: map[string]*config.ContextConfig{
+			context: {
+				Auth: &config.AuthConfig{
+					Credential: name,
+				},
+			},
+		},
+	}
+
+	if err := config.WriteConfig(cfg, cmd.Flags()); err != nil {
+		return err
+	}
+
+	Dialog.Infof("Using credential %s for context %s", name, context)
+
+	return nil
+}
+
+// authCredentialNames returns the names of the credentials stored for a
+// context in order, always including the default credential.
+func authCredentialNames(auth *config.AuthConfig) []string {
+	names := []string{config.DefaultAuthCredential}
+
+	if auth != nil {
+		for name := range auth.Credentials {
+			if name != config.DefaultAuthCredential {
+				names = append(names, name)
+			}
+		}
+	}
+
+	sort.Strings(names[1:])
+
+	return names
+}
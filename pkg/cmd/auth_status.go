@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/puppetlabs/relay/pkg/config"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/spf13/cobra"
+)
+
+func newAuthStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "status",
+		Aliases: []string{"whoami"},
+		Short:   "Show who you are logged in to Relay as",
+		Long: `Show who you are logged in to Relay as.
+
+Shows the current context, the credential in use, the type of token used to
+authenticate (an API token takes precedence over a session token), the user and
+account the token belongs to, and the name and expiry of the token where they
+are known.
+
+With --check, the command exits non-zero if there is no token for the current
+context or the token has expired or is not accepted by Relay, so it can be used
+as a preflight check in scripts.`,
+		Args: cobra.NoArgs,
+		RunE: doAuthStatus,
+	}
+
+	cmd.Flags().Bool("check", false, "Exit non-zero if the token is missing, expired or invalid")
+
+	return cmd
+}
+
+func doAuthStatus(cmd *cobra.Command, args []string) error {
+	check, ferr := cmd.Flags().GetBool("check")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	context := Config.CurrentContext
+
+	auth := currentAuthConfig()
+	credential := auth.CredentialName()
+
+	typ, value, ok := auth.Token()
+	if !ok {
+		if check {
+			return errors.NewAuthNotLoggedInError(context)
+		}
+
+		Dialog.Infof("Not logged in to context %s with credential %s. Log in with `relay auth login`.", context, credential)
+		return nil
+	}
+
+	token := model.Token(value)
+
+	var tokenID string
+	expires := "unknown"
+	status := "valid"
+
+	var err errors.Error
+
+	if claims, ok := token.Claims(); ok {
+		tokenID = claims.ID
+
+		if exp, ok := claims.Expires(); ok {
+			expires = exp.Format(time.RFC3339)
+
+			if time.Now().After(exp) {
+				status = "expired"
+				err = errors.NewAuthExpiredTokenError(context, typ.String(), expires)
+			}
+		} else {
+			expires = "never"
+		}
+	}
+
+	user, account, name := "unknown", "unknown", "-"
+
+	if err == nil {
+		Dialog.Progress("Checking credentials...")
+
+		profile, perr := Client.GetProfile()
+		if perr != nil {
+			status = "invalid"
+			err = errors.NewAuthInvalidTokenError(context, typ.String()).WithCause(perr)
+		} else {
+			if profile.User != nil {
+				user = profile.User.Name
+				if profile.User.Email != "" {
+					user += " <" + profile.User.Email + ">"
+				}
+			}
+
+			if resp, aerr := Client.GetAccount(); aerr == nil && resp.Account != nil {
+				account = resp.Account.Name
+			}
+
+			if typ == config.AuthTokenTypeAPI {
+				name = apiTokenName(cmd, tokenID)
+			}
+		}
+	}
+
+	if check && err != nil {
+		return err
+	}
+
+	t := Dialog.Table()
+
+	t.Headers([]string{"Context", "Credential", "Token Type", "User", "Account", "Token Name", "Expires", "Status"})
+	t.AppendRow([]string{context, credential, typ.String(), user, account, name, expires, status})
+
+	t.Flush()
+
+	if err != nil {
+		Dialog.Warn(err.FormattedDescription().Friendly())
+	}
+
+	return nil
+}
+
+// apiTokenName looks up the name of an API token of the authenticated user by
+// its ID.
+func apiTokenName(cmd *cobra.Command, id string) string {
+	if id == "" {
+		return "unknown"
+	}
+
+	req := Client.Api.TokensApi.GetTokens(cmd.Context())
+	tokens, _, err := Client.Api.TokensApi.GetTokensExecute(req.Owned(true).Valid(true))
+	if err != nil {
+		return "unknown"
+	}
+
+	for _, token := range tokens.GetTokens() {
+		if token.UserToken != nil && token.UserToken.GetId() == id {
+			return token.UserToken.GetName()
+		}
+	}
+
+	return "unknown"
+}
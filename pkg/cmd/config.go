@@ -37,7 +37,7 @@ func newConfigAuthCommand() *cobra.Command {
 func newConfigAuthClearCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "clear",
-		Short: "Clear stored authentication data for the current context",
+		Short: "Clear stored authentication data of the credential in use for the current context",
 		Args:  cobra.ExactArgs(0),
 		RunE:  doConfigAuthClear,
 	}
@@ -59,7 +59,8 @@ func doConfigAuthClear(cmd *cobra.Command, args []string) error {
 		ContextConfig: map[string]*config.ContextConfig{
 			context: {
 				Auth: &config.AuthConfig{
-					Tokens: map[config.AuthTokenType]string{},
+					Credential: currentAuthConfig().CredentialName(),
+					Tokens:     map[config.AuthTokenType]string{},
 				},
 			},
 		},
@@ -0,0 +1,409 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/puppetlabs/relay/pkg/debug"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/puppetlabs/relay/pkg/util"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+type connectionField struct {
+	Name      string
+	Prompt    string
+	Sensitive bool
+}
+
+// connectionTypeFields are the fields prompted for when creating a connection
+// of a known type. Sensitive fields are read with hidden input.
+var connectionTypeFields = map[string][]connectionField{
+	"aws": {
+		{Name: "accessKeyID", Prompt: "Access key ID"},
+		{Name: "secretAccessKey", Prompt: "Secret access key", Sensitive: true},
+	},
+	"azure": {
+		{Name: "subscriptionID", Prompt: "Subscription ID"},
+		{Name: "clientID", Prompt: "Client ID"},
+		{Name: "tenantID", Prompt: "Tenant ID"},
+		{Name: "secret", Prompt: "Client secret", Sensitive: true},
+	},
+	"gcp": {
+		{Name: "serviceAccountInfo", Prompt: "Service account key (JSON)", Sensitive: true},
+	},
+	"kubernetes": {
+		{Name: "server", Prompt: "Server URL"},
+		{Name: "certificateAuthority", Prompt: "Certificate authority (PEM)"},
+		{Name: "token", Prompt: "Token", Sensitive: true},
+	},
+}
+
+func newConnectionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "connection",
+		Short: "Manage your Relay connections",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(newListConnectionsCommand())
+	cmd.AddCommand(newCreateConnectionCommand())
+	cmd.AddCommand(newDeleteConnectionCommand())
+
+	return cmd
+}
+
+func newListConnectionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List Relay connections",
+		Args:  cobra.NoArgs,
+		RunE:  doListConnections,
+	}
+
+	cmd.Flags().String("type", "", "Only list connections of this type")
+
+	return cmd
+}
+
+func doListConnections(cmd *cobra.Command, args []string) error {
+	typ, ferr := cmd.Flags().GetString("type")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	Dialog.Progress("Listing connections...")
+
+	resp, err := Client.ListConnections()
+	if err != nil {
+		debug.Logf("failed to list connections: %s", err.Error())
+		return err
+	}
+
+	connections := filterConnections(resp.Connections, typ)
+
+	t := Dialog.Table()
+
+	t.Headers([]string{"Type", "Name", "Status", "Workflows"})
+
+	for _, connection := range connections {
+		status := ""
+		if connection.Availability != nil {
+			status = connection.Availability.Status
+		}
+
+		workflows := make([]string, 0, len(connection.Workflows))
+		for _, workflow := range connection.Workflows {
+			workflows = append(workflows, workflow.Name)
+		}
+
+		t.AppendRow([]string{connection.Type, connection.Name, status, strings.Join(workflows, ", ")})
+	}
+
+	t.Flush()
+
+	return nil
+}
+
+func newCreateConnectionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [connection type] [connection name]",
+		Short: "Create a Relay connection",
+		Long: `Create a Relay connection.
+
+Fields of the connection are given with --field, read from files with
+--field-file, or read from stdin with --field-stdin. For aws, azure, gcp and
+kubernetes connections, any fields that are not given are prompted for, with
+hidden input for keys and tokens.
+
+Steps use the connection with !Connection [<type>, <name>].`,
+		Args: cobra.MaximumNArgs(2),
+		RunE: doCreateConnection,
+	}
+
+	cmd.Flags().StringArray("field", nil, "Connection field as key=value (can be repeated)")
+	cmd.Flags().StringArray("field-file", nil, "Connection field read from a file as key=path, such as a GCP service account key (can be repeated)")
+	cmd.Flags().String("field-stdin", "", "Name of a connection field to read from stdin")
+
+	return cmd
+}
+
+func doCreateConnection(cmd *cobra.Command, args []string) error {
+	fieldFlags, ferr := cmd.Flags().GetStringArray("field")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	fieldFileFlags, ferr := cmd.Flags().GetStringArray("field-file")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	fieldStdin, ferr := cmd.Flags().GetString("field-stdin")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	typ, err := getConnectionType(args)
+	if err != nil {
+		return err
+	}
+
+	name, err := getConnectionName(args)
+	if err != nil {
+		return err
+	}
+
+	fields, err := parseConnectionFields(fieldFlags)
+	if err != nil {
+		return err
+	}
+
+	files, err := parseConnectionFields(fieldFileFlags)
+	if err != nil {
+		return err
+	}
+
+	for field, path := range files {
+		b, rerr := ioutil.ReadFile(path)
+		if rerr != nil {
+			return errors.NewConnectionFieldFileReadError(path).WithCause(rerr)
+		}
+
+		fields[field] = string(b)
+	}
+
+	if fieldStdin != "" {
+		value, err := readSecretValueStdin()
+		if err != nil {
+			return err
+		}
+
+		fields[fieldStdin] = value
+	}
+
+	for _, field := range connectionTypeFields[typ] {
+		if _, ok := fields[field.Name]; ok {
+			continue
+		}
+
+		value, err := readConnectionField(field)
+		if err != nil {
+			return err
+		}
+
+		fields[field.Name] = value
+	}
+
+	if len(fields) == 0 {
+		return errors.NewConnectionMissingFieldsError()
+	}
+
+	Dialog.Progress("Creating connection...")
+
+	if _, err := Client.CreateConnection(typ, name, fields); err != nil {
+		return err
+	}
+
+	Dialog.Infof(`Successfully created %v connection %v
+
+Use it in a workflow step with: !Connection [%v, %v]`,
+		typ, name, typ, name,
+	)
+
+	return nil
+}
+
+func newDeleteConnectionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [connection type] [connection name]",
+		Short: "Delete a Relay connection",
+		Args:  cobra.MaximumNArgs(2),
+		RunE:  doDeleteConnection,
+	}
+
+	return cmd
+}
+
+func doDeleteConnection(cmd *cobra.Command, args []string) error {
+	typ, err := getConnectionType(args)
+	if err != nil {
+		return err
+	}
+
+	name, err := getConnectionName(args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := Client.ListConnections()
+	if err != nil {
+		debug.Logf("failed to list connections: %s", err.Error())
+		return err
+	}
+
+	var connection *model.Connection
+	for _, c := range filterConnections(resp.Connections, typ) {
+		if c.Name == name {
+			connection = c
+			break
+		}
+	}
+
+	if connection == nil {
+		return errors.NewConnectionNotFoundError(typ, name)
+	}
+
+	if len(connection.Workflows) > 0 {
+		names := make([]string, 0, len(connection.Workflows))
+		for _, wf := range connection.Workflows {
+			if wf != nil {
+				names = append(names, wf.Name)
+			}
+		}
+
+		sort.Strings(names)
+
+		Dialog.Warnf("This connection is used by %d workflows: %s", len(connection.Workflows), strings.Join(names, ", "))
+	}
+
+	proceed, err := util.Confirm("Are you sure you want to delete this connection?", Config)
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	Dialog.Progress("Deleting connection...")
+	_, err = Client.DeleteConnection(connection.ID)
+	if err != nil {
+		return err
+	}
+	Dialog.Info("Connection successfully deleted")
+
+	return nil
+}
+
+// filterConnections returns the connections of the given type, or all
+// connections if the type is empty, sorted by type and name.
+func filterConnections(connections []*model.Connection, typ string) []*model.Connection {
+	var filtered []*model.Connection
+
+	for _, connection := range connections {
+		if connection == nil || connection.ConnectionSummary == nil || connection.ConnectionIdentifier == nil {
+			continue
+		}
+
+		if typ == "" || connection.Type == typ {
+			filtered = append(filtered, connection)
+		}
+	}
+
+	sort.Slice(filtered, func(i, j int) bool {
+		if filtered[i].Type != filtered[j].Type {
+			return filtered[i].Type < filtered[j].Type
+		}
+
+		return filtered[i].Name < filtered[j].Name
+	})
+
+	return filtered
+}
+
+// parseConnectionFields parses key=value flags. The value may itself contain
+// an equals sign.
+func parseConnectionFields(flags []string) (map[string]string, errors.Error) {
+	fields := make(map[string]string, len(flags))
+
+	for _, flag := range flags {
+		parts := strings.SplitN(flag, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.NewConnectionInvalidFieldError(flag)
+		}
+
+		fields[parts[0]] = parts[1]
+	}
+
+	return fields, nil
+}
+
+func readConnectionField(field connectionField) (string, errors.Error) {
+	fmt.Printf("%s: ", field.Prompt)
+
+	if field.Sensitive {
+		valueBytes, err := terminal.ReadPassword(int(syscall.Stdin))
+		if err != nil {
+			return "", errors.NewConnectionFieldReadError(field.Name).WithCause(err)
+		}
+
+		// resets to new line after hidden input
+		fmt.Println("")
+
+		return string(valueBytes), nil
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+
+	value, err := reader.ReadString('\n')
+	if err != nil {
+		return "", errors.NewConnectionFieldReadError(field.Name).WithCause(err)
+	}
+
+	return strings.TrimSpace(value), nil
+}
+
+// getConnectionType gets the type of the connection from the first argument.
+// If none are supplied, reads it from stdin.
+func getConnectionType(args []string) (string, errors.Error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+
+	fmt.Print("Connection type: ")
+	typePrompt, err := reader.ReadString('\n')
+	if err != nil {
+		return "", errors.NewConnectionTypeReadError().WithCause(err)
+	}
+
+	typ := strings.TrimSpace(typePrompt)
+
+	if typ == "" {
+		return "", errors.NewConnectionMissingTypeError()
+	}
+
+	return typ, nil
+}
+
+// getConnectionName gets the name of the connection from the second argument.
+// If none are supplied, reads it from stdin.
+func getConnectionName(args []string) (string, errors.Error) {
+	if len(args) > 1 {
+		return args[1], nil
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+
+	fmt.Print("Connection name: ")
+	namePrompt, err := reader.ReadString('\n')
+	if err != nil {
+		return "", errors.NewConnectionNameReadError().WithCause(err)
+	}
+
+	name := strings.TrimSpace(namePrompt)
+
+	if name == "" {
+		return "", errors.NewConnectionMissingNameError()
+	}
+
+	return name, nil
+}
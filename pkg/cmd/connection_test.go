@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConnectionFields(t *testing.T) {
+	fields, err := parseConnectionFields([]string{"accessKeyID=AKIA", "secretAccessKey=a=b"})
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{"accessKeyID": "AKIA", "secretAccessKey": "a=b"}, fields)
+
+	_, err = parseConnectionFields([]string{"novalue"})
+	require.NotNil(t, err)
+
+	_, err = parseConnectionFields([]string{"=value"})
+	require.NotNil(t, err)
+}
+
+func TestFilterConnections(t *testing.T) {
+	connection := func(typ, name string) *model.Connection {
+		return &model.Connection{ConnectionSummary: &model.ConnectionSummary{
+			ConnectionIdentifier: &model.ConnectionIdentifier{ID: typ + "-" + name},
+			Type:                 typ,
+			Name:                 name,
+		}}
+	}
+
+	connections := []*model.Connection{
+		connection("kubernetes", "prod"),
+		connection("aws", "test"),
+		connection("aws", "prod"),
+		{ConnectionSummary: &model.ConnectionSummary{Type: "aws", Name: "unidentified"}},
+	}
+
+	require.Equal(t, []*model.Connection{connections[2], connections[1], connections[0]}, filterConnections(connections, ""))
+	require.Equal(t, []*model.Connection{connections[2], connections[1]}, filterConnections(connections, "aws"))
+}
@@ -43,6 +43,7 @@ func newDevCommand() *cobra.Command {
 
 	cmd.AddCommand(newInitializeCommand())
 	cmd.AddCommand(newMetadataCommand())
+	cmd.AddCommand(newDevWebhookCommand())
 
 	// TODO temporary workflow commands until `relay workflow` is integrated
 	// with the dev cluster
@@ -156,6 +157,11 @@ func doDevWorkflowRun(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	runParams, perr := parseParameters(params)
+	if perr != nil {
+		return perr
+	}
+
 	ctx := cmd.Context()
 	dm, err := dev.NewManager(ctx)
 	if err != nil {
@@ -179,7 +185,7 @@ func doDevWorkflowRun(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	_, err = dm.RunWorkflow(ctx, wf, parseParameters(params))
+	_, err = dm.RunWorkflow(ctx, wf, runParams)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/puppetlabs/relay/pkg/dev"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newDevWebhookCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook",
+		Short: "Work with webhook requests locally",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(newDevWebhookCaptureCommand())
+
+	return cmd
+}
+
+func newDevWebhookCaptureCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capture",
+		Short: "Record incoming webhook requests as fixture files",
+		Long: `Record incoming webhook requests as fixture files.
+
+Starts an HTTP listener and writes every request it receives to the --out
+directory as request-NNNN.json, describing the method, path and time of the
+request, with the body in request-NNNN.body and the headers in
+request-NNNN.headers.json. A captured request can be sent to a webhook trigger
+with:
+
+  relay workflow webhook replay --payload request-0001.body --headers request-0001.headers.json
+
+With --forward, each request is also sent on to the given URL, such as the
+endpoint of a webhook trigger, and its response is returned to the sender.`,
+		Args: cobra.NoArgs,
+		RunE: doDevWebhookCapture,
+	}
+
+	cmd.Flags().Int("port", 9000, "Port to listen on")
+	cmd.Flags().String("host", "localhost", "Host to listen on")
+	cmd.Flags().String("out", "fixtures", "Directory to write captured requests to")
+	cmd.Flags().String("dir", "", "Directory to write captured requests to")
+	cmd.Flags().MarkHidden("dir")
+	cmd.Flags().String("forward", "", "URL to forward each request to, such as a webhook trigger endpoint")
+
+	return cmd
+}
+
+func doDevWebhookCapture(cmd *cobra.Command, args []string) error {
+	port, ferr := cmd.Flags().GetInt("port")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	host, ferr := cmd.Flags().GetString("host")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	dir, ferr := cmd.Flags().GetString("out")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	// --dir is the name the flag was first released with.
+	if cmd.Flags().Changed("dir") && !cmd.Flags().Changed("out") {
+		if dir, ferr = cmd.Flags().GetString("dir"); ferr != nil {
+			return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+		}
+	}
+
+	forward, ferr := cmd.Flags().GetString("forward")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+
+	opts := dev.WebhookCaptureOptions{
+		Address:    address,
+		Dir:        dir,
+		ForwardURL: forward,
+		OnCapture: func(c *dev.CapturedWebhookRequest) {
+			msg := fmt.Sprintf("%s %s captured to %s", c.Method, c.Path, c.BodyFile)
+
+			switch {
+			case c.ForwardError != "":
+				Dialog.Warnf("%s, but could not be forwarded: %s", msg, c.ForwardError)
+			case c.ForwardStatus != "":
+				Dialog.Infof("%s and forwarded with status %s", msg, c.ForwardStatus)
+			default:
+				Dialog.Info(msg)
+			}
+		},
+	}
+
+	addr := make(chan string, 1)
+	go func() {
+		Dialog.Infof("Capturing webhook requests sent to http://%s in %s", <-addr, dir)
+	}()
+
+	if err := dev.CaptureWebhooks(cmd.Context(), opts, addr); err != nil {
+		return errors.NewWorkflowWebhookCaptureError(address).WithCause(err)
+	}
+
+	return nil
+}
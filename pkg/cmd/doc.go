@@ -95,7 +95,7 @@ func genChildMarkdown(cmd *cobra.Command, buf *bytes.Buffer) error {
 		if len(long) > 0 {
 			buf.WriteString("  " + cmd.Long + "\n")
 		}
-		flags := cmd.NonInheritedFlags()
+		flags := localFlags(cmd)
 		if flags.HasAvailableFlags() {
 			buf.WriteString("```\n")
 			flags.SetOutput(buf)
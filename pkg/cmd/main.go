@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"os"
+	"strings"
 
 	"github.com/puppetlabs/relay/pkg/client"
 	"github.com/puppetlabs/relay/pkg/config"
@@ -9,6 +10,7 @@ import (
 	"github.com/puppetlabs/relay/pkg/dialog"
 	"github.com/puppetlabs/relay/pkg/format"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 // CommandName is the top level command that we're building
@@ -26,6 +28,39 @@ var Client = client.NewClient(Config)
 // Dialog is the UI to use derrived from the current configuration.
 var Dialog = dialog.FromConfig(Config)
 
+func init() {
+	cobra.AddTemplateFunc("localFlags", localFlags)
+	cobra.AddTemplateFunc("inheritedFlags", inheritedFlags)
+}
+
+// localFlags returns the flags defined by a command itself. Unlike
+// cmd.LocalFlags, it includes a flag that has the same name as a global flag
+// and so takes its place.
+func localFlags(cmd *cobra.Command) *pflag.FlagSet {
+	inherited := cmd.InheritedFlags()
+
+	flags := pflag.NewFlagSet(cmd.Name(), pflag.ContinueOnError)
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if inherited.Lookup(f.Name) != f {
+			flags.AddFlag(f)
+		}
+	})
+
+	return flags
+}
+
+// inheritedFlags returns the global flags that apply to a command.
+func inheritedFlags(cmd *cobra.Command) *pflag.FlagSet {
+	flags := pflag.NewFlagSet(cmd.Name(), pflag.ContinueOnError)
+	cmd.InheritedFlags().VisitAll(func(f *pflag.Flag) {
+		if cmd.Flags().Lookup(f.Name) == f {
+			flags.AddFlag(f)
+		}
+	})
+
+	return flags
+}
+
 func getCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:           CommandName,
@@ -46,8 +81,11 @@ Use the 'workflow' subcommand to interact with workflows:
 ▶️   relay workflow
 `,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			// This turns off usage info in json output mode
-			cfg, err := config.FromFlags(cmd.Flags())
+			// This turns off usage info in json output mode. The global flags
+			// are read from the root command so that a subcommand flag of the
+			// same name, like the --out directory of dev webhook capture, is
+			// not mistaken for them.
+			cfg, err := config.FromFlags(cmd.Root().PersistentFlags())
 
 			if err != nil {
 				// What kind of error could this be? We will abort accordingly.
@@ -81,9 +119,17 @@ Use the 'workflow' subcommand to interact with workflows:
 	// Hide unwanted imported flags
 	cmd.LocalFlags().MarkHidden("azure-container-registry-config")
 
+	// List a subcommand flag that hides a global flag of the same name under
+	// the subcommand's own flags.
+	cmd.SetUsageTemplate(strings.NewReplacer(
+		".LocalFlags.", "(localFlags .).",
+		".InheritedFlags.", "(inheritedFlags .).",
+	).Replace(cmd.UsageTemplate()))
+
 	cmd.AddCommand(newAuthCommand())
 	cmd.AddCommand(newConfigCommand())
 	cmd.AddCommand(newContextCommand())
+	cmd.AddCommand(newConnectionCommand())
 	cmd.AddCommand(newWorkflowCommand())
 	cmd.AddCommand(newDevCommand())
 	cmd.AddCommand(newDocCommand())
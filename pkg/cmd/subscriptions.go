@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"context"
+
 	"github.com/puppetlabs/relay-client-go/client/pkg/client/openapi"
 	"github.com/spf13/cobra"
 )
@@ -55,20 +57,38 @@ func newUnsubscribeUserWorkflow() *cobra.Command {
 func doListUserWorkflowSubscriptions(cmd *cobra.Command, args []string) error {
 	Dialog.Progress("Listing workflow subscriptions...")
 
-	req := Client.Api.SubscriptionsApi.GetWorkflowsSubscriptions(cmd.Context())
-	uws, _, err := Client.Api.SubscriptionsApi.GetWorkflowsSubscriptionsExecute(req)
+	names, err := getSubscribedWorkflowNames(cmd.Context())
 	if err != nil {
 		return err
 	}
 
+	for _, name := range names {
+		Dialog.Infof(name)
+	}
+
+	return nil
+}
+
+// getSubscribedWorkflowNames returns the names of the workflows the current
+// user is subscribed to.
+func getSubscribedWorkflowNames(ctx context.Context) ([]string, error) {
+	req := Client.Api.SubscriptionsApi.GetWorkflowsSubscriptions(ctx)
+	uws, _, err := Client.Api.SubscriptionsApi.GetWorkflowsSubscriptionsExecute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+
 	for _, wf := range uws.Workflows {
 		if wf.Subscriptions != nil &&
+			wf.Subscriptions.Subscribe != nil &&
 			*wf.Subscriptions.Subscribe {
-			Dialog.Infof(wf.Name)
+			names = append(names, wf.Name)
 		}
 	}
 
-	return nil
+	return names, nil
 }
 
 func doSubscribeUserWorkflow(cmd *cobra.Command, args []string) error {
@@ -120,7 +120,7 @@ func doCreateToken(cmd *cobra.Command, args []string) error {
 		}
 
 		if use {
-			writeAuthTokenConfig(cmd, *token.UserTokenWithSecret.Secret, config.AuthTokenTypeAPI)
+			writeAuthTokenConfig(cmd, currentAuthConfig().CredentialName(), *token.UserTokenWithSecret.Secret, config.AuthTokenTypeAPI)
 
 			Dialog.WriteString("The generated token has been added to the cached credentials\n" +
 				"To clear your cached credentials, use: relay config auth clear\n")
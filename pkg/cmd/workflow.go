@@ -10,6 +10,7 @@ import (
 	"github.com/puppetlabs/relay/pkg/debug"
 	"github.com/puppetlabs/relay/pkg/errors"
 	"github.com/puppetlabs/relay/pkg/format"
+	"github.com/puppetlabs/relay/pkg/lint"
 	"github.com/puppetlabs/relay/pkg/util"
 	"github.com/spf13/cobra"
 )
@@ -22,11 +23,24 @@ func newWorkflowCommand() *cobra.Command {
 	}
 
 	cmd.AddCommand(newSaveWorkflowCommand())
+	cmd.AddCommand(newApplyWorkflowsCommand())
 	cmd.AddCommand(newValidateWorkflowFileCommand())
+	cmd.AddCommand(newLintWorkflowFileCommand())
+	cmd.AddCommand(newFmtWorkflowFileCommand())
+	cmd.AddCommand(newGraphWorkflowCommand())
 	cmd.AddCommand(newDeleteWorkflowCommand())
 	cmd.AddCommand(newRunWorkflowCommand())
+	cmd.AddCommand(newPushWorkflowEventCommand())
+	cmd.AddCommand(newScheduleWorkflowCommand())
+	cmd.AddCommand(newWorkflowWebhookCommand())
+	cmd.AddCommand(newWorkflowRunsCommand())
+	cmd.AddCommand(newWorkflowLogsCommand())
+	cmd.AddCommand(newWorkflowTriggersCommand())
+	cmd.AddCommand(newWorkflowRevisionsCommand())
+	cmd.AddCommand(newRollbackWorkflowCommand())
 	cmd.AddCommand(newListWorkflowsCommand())
 	cmd.AddCommand(newDownloadWorkflowCommand())
+	cmd.AddCommand(newExportWorkflowsCommand())
 	cmd.AddCommand(newSecretCommand())
 
 	// Deprecated
@@ -86,15 +100,45 @@ func doValidateWorkflowFile(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	Dialog.Info("Validating workflow file " + filepath)
+	format, err := getFindingsFormat(cmd)
+
+	if err != nil {
+		return err
+	}
+
+	dlg := findingsDialog(format)
+
+	dlg.Info("Validating workflow file " + filepath)
 
 	_, rerr := Client.Validate(file)
 
+	if format != findingsFormatText {
+		// Only requests rejected by the API describe problems with the file;
+		// anything else, like a failure to reach the API, is reported as
+		// usual.
+		if rerr != nil && !(errors.IsClientRequestRejected(rerr) && len(rerr.Causes()) > 0) {
+			return rerr
+		}
+
+		result := &lint.Result{File: filepath}
+		if rerr != nil {
+			result.Findings = validationFindings(file, rerr)
+		}
+
+		if err := outputFindings(format, []*lint.Result{result}); err != nil {
+			return err
+		}
+
+		if rerr != nil {
+			return errors.NewWorkflowLintFailedError(fmt.Sprintf("%d", len(result.Findings)))
+		}
+	}
+
 	if rerr != nil {
 		return rerr
 	}
 
-	Dialog.Infof(`Successfully validated workflow file %v`, filepath)
+	dlg.Infof(`Successfully validated workflow file %v`, filepath)
 
 	return nil
 }
@@ -108,6 +152,7 @@ func newValidateWorkflowFileCommand() *cobra.Command {
 	}
 
 	cmd.Flags().StringP("file", "f", "", "Path to Relay workflow file")
+	cmd.Flags().String("format", "", "Format of reported findings: (text|json|sarif) (default text, or json with --out json)")
 
 	return cmd
 }
@@ -153,30 +198,46 @@ func newDeleteWorkflowCommand() *cobra.Command {
 	return cmd
 }
 
-func parseParameter(str string) (key, value string) {
+func parseParameter(str string) (key, value string, err errors.Error) {
 	strs := strings.SplitN(str, "=", 2)
 
-	if len(strs) == 2 {
-		return strs[0], strs[1]
+	if len(strs) == 2 && strs[0] != "" {
+		return strs[0], strs[1], nil
 	}
 
 	debug.Logf("invalid parameter: %s", str)
-	return "", ""
+	return "", "", errors.NewWorkflowInvalidParameterError(str)
 }
 
-func parseParameters(strs []string) map[string]string {
+func parseParameters(strs []string) (map[string]string, errors.Error) {
 	res := make(map[string]string)
 
 	for _, str := range strs {
-		key, val := parseParameter(str)
-
 		// value of empty string could, indeed, be a valid parameter.
-		if key != "" {
-			res[key] = val
+		key, val, err := parseParameter(str)
+		if err != nil {
+			return nil, err
 		}
+
+		res[key] = val
+	}
+
+	return res, nil
+}
+
+func parseParameterValues(strs []string) (map[string]interface{}, errors.Error) {
+	params, err := parseParameters(strs)
+	if err != nil {
+		return nil, err
 	}
 
-	return res
+	res := make(map[string]interface{}, len(params))
+
+	for key, val := range params {
+		res[key] = val
+	}
+
+	return res, nil
 }
 
 func doRunWorkflow(cmd *cobra.Command, args []string) error {
@@ -187,6 +248,12 @@ func doRunWorkflow(cmd *cobra.Command, args []string) error {
 		return errors.NewGeneralUnknownError().WithCause(err).Bug()
 	}
 
+	paramsFile, err := cmd.Flags().GetString("parameters-file")
+
+	if err != nil {
+		return errors.NewGeneralUnknownError().WithCause(err).Bug()
+	}
+
 	// TODO: Same here as above. Could really DRY all this up.
 	name, err := getWorkflowName(args)
 
@@ -194,9 +261,19 @@ func doRunWorkflow(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	values, err := getRunParameters(paramsFile, params)
+
+	if err != nil {
+		return err
+	}
+
+	if err := validateRunParameters(name, values); err != nil {
+		return err
+	}
+
 	Dialog.Progress("Starting your workflow...")
 
-	resp, err := Client.RunWorkflow(name, parseParameters(params))
+	resp, err := Client.RunWorkflow(name, values)
 
 	if err != nil {
 		return err
@@ -205,6 +282,15 @@ func doRunWorkflow(cmd *cobra.Command, args []string) error {
 	link := format.GuiLink(Config, "/workflows/%s/runs/%d/graph", name, resp.Run.RunNumber)
 	Dialog.Info(fmt.Sprintf("Your run has started. Monitor its progress here: %s", link))
 
+	watch, err := cmd.Flags().GetBool("watch")
+	if err != nil {
+		return errors.NewGeneralUnknownError().WithCause(err).Bug()
+	}
+
+	if watch {
+		return watchWorkflowRun(cmd, name, resp.Run.RunNumber)
+	}
+
 	return nil
 }
 
@@ -218,6 +304,9 @@ func newRunWorkflowCommand() *cobra.Command {
 	}
 
 	cmd.Flags().StringArrayP("parameter", "p", []string{}, "Parameters to invoke this workflow run with")
+	cmd.Flags().StringP("parameters-file", "P", "", "Path to a YAML or JSON file of parameters to invoke this workflow run with")
+	cmd.Flags().BoolP("watch", "w", false, "Wait for the run to finish, exiting non-zero if it does not succeed")
+	cmd.Flags().Duration("timeout", 0, "Maximum time to wait for the run to finish when watching (default no limit)")
 
 	return cmd
 }
@@ -229,7 +318,18 @@ func doDownloadWorkflow(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	body, err := Client.DownloadWorkflow(name)
+	revision, ferr := cmd.Flags().GetString("revision")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	var body string
+
+	if revision == "" {
+		body, err = Client.DownloadWorkflow(name)
+	} else {
+		body, err = downloadWorkflowRevision(name, revision)
+	}
 
 	if err != nil {
 		if errors.IsClientResponseNotFound(err) {
@@ -271,10 +371,20 @@ func newDownloadWorkflowCommand() *cobra.Command {
 	}
 
 	cmd.Flags().StringP("file", "f", "", "Path to write workflow file")
+	cmd.Flags().String("revision", "", "ID of the revision to download instead of the latest revision")
 
 	return cmd
 }
 
+func downloadWorkflowRevision(name, revision string) (string, errors.Error) {
+	rev, err := Client.GetRevision(name, revision)
+	if err != nil {
+		return "", err
+	}
+
+	return decodeRevision(rev.Revision)
+}
+
 func doListWorkflows(cmd *cobra.Command, args []string) error {
 	req := Client.Api.ViewsApi.GetWorkflowsView(cmd.Context())
 	wv, _, err := Client.Api.ViewsApi.GetWorkflowsViewExecute(req)
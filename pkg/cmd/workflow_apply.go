@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/puppetlabs/relay/pkg/debug"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+const (
+	applyActionCreate    = "create"
+	applyActionUpdate    = "update"
+	applyActionUnchanged = "unchanged"
+	applyActionDelete    = "delete"
+)
+
+type workflowApplyAction struct {
+	Name    string
+	Action  string
+	File    string
+	Content string
+}
+
+func newApplyWorkflowsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Make your Relay workflows match a directory of workflow files",
+		Long: `Make your Relay workflows match a directory of workflow files.
+
+Each <name>.yaml or <name>.yml file in the directory is saved as the workflow
+<name>. A new revision is only created when the file differs from the latest
+revision of the workflow. The planned changes are printed before they are made.`,
+		Args: cobra.NoArgs,
+		RunE: doApplyWorkflows,
+	}
+
+	cmd.Flags().StringP("dir", "d", "workflows", "Directory to read workflow files from")
+	// -d is the shorthand of --dir here, so --debug is defined again without
+	// the shorthand to take the place of the global flag.
+	cmd.Flags().BoolVar(&debug.Enabled, "debug", false, "Print debugging information")
+	cmd.Flags().Bool("prune", false, "Delete workflows that do not have a file in the directory")
+	cmd.Flags().Bool("dry-run", false, "Print the planned changes without making them")
+
+	return cmd
+}
+
+func doApplyWorkflows(cmd *cobra.Command, args []string) error {
+	dir, ferr := cmd.Flags().GetString("dir")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	prune, ferr := cmd.Flags().GetBool("prune")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	dryRun, ferr := cmd.Flags().GetBool("dry-run")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	files, err := readWorkflowDir(dir)
+	if err != nil {
+		return err
+	}
+
+	Dialog.Progress("Fetching workflows...")
+
+	req := Client.Api.ViewsApi.GetWorkflowsView(cmd.Context())
+	wv, _, verr := Client.Api.ViewsApi.GetWorkflowsViewExecute(req)
+	if verr != nil {
+		debug.Logf("failed to list workflows: %s", verr.Error())
+		return verr
+	}
+
+	remote := make(map[string]string, len(wv.Workflows))
+
+	for _, workflow := range wv.Workflows {
+		if _, ok := files[workflow.Name]; !ok {
+			remote[workflow.Name] = ""
+			continue
+		}
+
+		raw, err := Client.DownloadWorkflow(workflow.Name)
+		if err != nil && !errors.IsClientResponseNotFound(err) {
+			return err
+		}
+
+		remote[workflow.Name] = raw
+	}
+
+	contents := make(map[string]string, len(files))
+	for name, file := range files {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return errors.NewWorkflowWorkflowFileReadError().WithCause(err)
+		}
+
+		contents[name] = string(b)
+	}
+
+	plan := planWorkflowApply(contents, remote, prune)
+
+	t := Dialog.Table()
+
+	t.Headers([]string{"Workflow", "Action", "File"})
+
+	var changes, deletions int
+
+	for _, action := range plan {
+		action.File = files[action.Name]
+
+		switch action.Action {
+		case applyActionUnchanged:
+		case applyActionDelete:
+			deletions++
+			changes++
+		default:
+			changes++
+		}
+
+		t.AppendRow([]string{action.Name, action.Action, action.File})
+	}
+
+	t.Flush()
+
+	if dryRun {
+		Dialog.Infof("Dry run: %d workflows would be changed", changes)
+		return nil
+	}
+
+	if changes == 0 {
+		Dialog.Info("All workflows are up to date")
+		return nil
+	}
+
+	if deletions > 0 {
+		proceed, err := util.Confirm(fmt.Sprintf("Are you sure you want to delete %d workflows?", deletions), Config)
+		if err != nil {
+			return err
+		}
+
+		if !proceed {
+			return nil
+		}
+	}
+
+	for _, action := range plan {
+		switch action.Action {
+		case applyActionCreate:
+			if _, err := getOrCreateWorkflow(cmd, action.Name); err != nil {
+				return err
+			}
+
+			fallthrough
+		case applyActionUpdate:
+			Dialog.Progress(fmt.Sprintf("Saving workflow %s from %s", action.Name, action.File))
+
+			if _, err := Client.CreateRevision(action.Name, action.Content); err != nil {
+				return err
+			}
+		case applyActionDelete:
+			Dialog.Progress("Deleting workflow " + action.Name)
+
+			if _, err := Client.DeleteWorkflow(action.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	Dialog.Infof("Applied %d workflow changes from %s", changes, dir)
+
+	return nil
+}
+
+// readWorkflowDir maps workflow names to the YAML files in a directory that
+// define them.
+func readWorkflowDir(dir string) (map[string]string, errors.Error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.NewWorkflowApplyDirReadError(dir).WithCause(err)
+	}
+
+	files := make(map[string]string)
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		ext := filepath.Ext(entry.Name())
+		if ext != ".yaml" && ext != ".yml" {
+			continue
+		}
+
+		name := strings.TrimSuffix(entry.Name(), ext)
+		path := filepath.Join(dir, entry.Name())
+
+		if existing, ok := files[name]; ok {
+			return nil, errors.NewWorkflowApplyDuplicateWorkflowError(name, existing, path)
+		}
+
+		files[name] = path
+	}
+
+	return files, nil
+}
+
+// planWorkflowApply decides what to do with each workflow given the contents
+// of the local workflow files and the latest remote workflow files. Remote
+// workflows without a revision are mapped to an empty string. The plan is
+// sorted by workflow name.
+func planWorkflowApply(local, remote map[string]string, prune bool) []*workflowApplyAction {
+	var plan []*workflowApplyAction
+
+	for name, content := range local {
+		action := &workflowApplyAction{Name: name, Content: content}
+
+		if raw, ok := remote[name]; !ok {
+			action.Action = applyActionCreate
+		} else if normalizeWorkflowContent(raw) != normalizeWorkflowContent(content) {
+			action.Action = applyActionUpdate
+		} else {
+			action.Action = applyActionUnchanged
+		}
+
+		plan = append(plan, action)
+	}
+
+	if prune {
+		for name := range remote {
+			if _, ok := local[name]; !ok {
+				plan = append(plan, &workflowApplyAction{Name: name, Action: applyActionDelete})
+			}
+		}
+	}
+
+	sort.Slice(plan, func(i, j int) bool {
+		return plan[i].Name < plan[j].Name
+	})
+
+	return plan
+}
+
+func normalizeWorkflowContent(content string) string {
+	return strings.TrimRight(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+}
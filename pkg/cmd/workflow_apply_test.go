@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanWorkflowApply(t *testing.T) {
+	local := map[string]string{
+		"build":  "steps:\n- name: build\n",
+		"deploy": "steps:\n- name: deploy\n",
+		"new":    "steps: []\n",
+		"empty":  "steps: []\n",
+	}
+
+	remote := map[string]string{
+		"build":  "steps:\n- name: build",
+		"deploy": "steps:\n- name: deploy-v1\n",
+		"empty":  "",
+		"old":    "steps: []\n",
+	}
+
+	actions := func(prune bool) map[string]string {
+		res := make(map[string]string)
+		for _, action := range planWorkflowApply(local, remote, prune) {
+			res[action.Name] = action.Action
+		}
+
+		return res
+	}
+
+	require.Equal(t, map[string]string{
+		"build":  applyActionUnchanged,
+		"deploy": applyActionUpdate,
+		"empty":  applyActionUpdate,
+		"new":    applyActionCreate,
+	}, actions(false))
+
+	require.Equal(t, applyActionDelete, actions(true)["old"])
+}
+
+func TestReadWorkflowDir(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, name := range []string{"build.yaml", "deploy.yml", "notes.txt"} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte("steps: []\n"), 0644))
+	}
+
+	files, err := readWorkflowDir(dir)
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{
+		"build":  filepath.Join(dir, "build.yaml"),
+		"deploy": filepath.Join(dir, "deploy.yml"),
+	}, files)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "build.yml"), []byte("steps: []\n"), 0644))
+
+	_, err = readWorkflowDir(dir)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/puppetlabs/relay/pkg/client"
+	"github.com/puppetlabs/relay/pkg/debug"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/format"
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/puppetlabs/relay/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+func newApproveWorkflowRunStepCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [workflow name] [run number] [step name]",
+		Short: "Approve a workflow run step that is waiting for approval",
+		Args:  cobra.MaximumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doSetWorkflowRunStepApproval(cmd, args, client.StepApprovalApproved)
+		},
+	}
+
+	return cmd
+}
+
+func newDenyWorkflowRunStepCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deny [workflow name] [run number] [step name]",
+		Short: "Deny a workflow run step that is waiting for approval",
+		Args:  cobra.MaximumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doSetWorkflowRunStepApproval(cmd, args, client.StepApprovalRejected)
+		},
+	}
+
+	return cmd
+}
+
+func doSetWorkflowRunStepApproval(cmd *cobra.Command, args []string, approval string) error {
+	name, err := getWorkflowName(args)
+	if err != nil {
+		return err
+	}
+
+	runNumber, err := getRunNumber(args)
+	if err != nil {
+		return err
+	}
+
+	step, err := getStepName(args)
+	if err != nil {
+		return err
+	}
+
+	verb := "approve"
+	if approval == client.StepApprovalRejected {
+		verb = "deny"
+	}
+
+	proceed, err := util.Confirm(fmt.Sprintf("Are you sure you want to %s step %s of run %d?", verb, step, runNumber), Config)
+	if err != nil {
+		return err
+	}
+
+	if !proceed {
+		return nil
+	}
+
+	Dialog.Progress("Submitting approval...")
+
+	if _, err := Client.SetWorkflowRunStepApproval(name, runNumber, step, approval); err != nil {
+		return err
+	}
+
+	Dialog.Infof("Step %s of run %d is now %s", step, runNumber, approval)
+
+	return nil
+}
+
+func newListPendingApprovalsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending",
+		Short: "List run steps waiting for approval in your subscribed workflows",
+		Args:  cobra.NoArgs,
+		RunE:  doListPendingApprovals,
+	}
+
+	return cmd
+}
+
+func doListPendingApprovals(cmd *cobra.Command, args []string) error {
+	Dialog.Progress("Looking for pending approvals...")
+
+	names, err := getSubscribedWorkflowNames(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	t := Dialog.Table()
+
+	t.Headers([]string{"Workflow", "Run", "Step", "Waiting Since", "Link"})
+
+	for _, name := range names {
+		runs, err := Client.ListWorkflowRuns(name)
+		if err != nil {
+			debug.Logf("failed to list runs of workflow %s: %s", name, err.Error())
+			return err
+		}
+
+		for _, summary := range runs.Runs {
+			if summary.State == nil || client.IsTerminalRunStatus(summary.State.Status) {
+				continue
+			}
+
+			resp, err := Client.GetWorkflowRun(name, int(summary.RunNumber))
+			if err != nil {
+				return err
+			}
+
+			if resp.Run.State == nil {
+				continue
+			}
+
+			for _, stepName := range sortedStepNames(resp.Run.State.Steps) {
+				step := resp.Run.State.Steps[stepName]
+				if !isPendingApproval(step) {
+					continue
+				}
+
+				t.AppendRow([]string{
+					name,
+					fmt.Sprintf("%d", summary.RunNumber),
+					stepName,
+					formatTime(step.StartedAt),
+					format.GuiLink(Config, "/workflows/%s/runs/%d/graph", name, summary.RunNumber),
+				})
+			}
+		}
+	}
+
+	t.Flush()
+
+	return nil
+}
+
+// isPendingApproval returns true if the step is an approval step that is
+// waiting for a decision.
+func isPendingApproval(step *model.WorkflowRunStepState) bool {
+	if step == nil || step.Type != approvalStepType || step.Status != client.RunStatusWaiting {
+		return false
+	}
+
+	return step.Approval == "" || step.Approval == client.StepApprovalWaiting
+}
+
+// getStepName gets the name of the step from the third argument. If none is
+// supplied, reads it from stdin.
+func getStepName(args []string) (string, errors.Error) {
+	if len(args) > 2 {
+		return args[2], nil
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+
+	fmt.Print("Step name: ")
+	namePrompt, err := reader.ReadString('\n')
+	if err != nil {
+		return "", errors.NewWorkflowStepNameReadError().WithCause(err)
+	}
+
+	name := strings.TrimSpace(namePrompt)
+
+	if name == "" {
+		return "", errors.NewWorkflowMissingStepNameError()
+	}
+
+	return name, nil
+}
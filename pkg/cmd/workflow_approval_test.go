@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPendingApproval(t *testing.T) {
+	step := func(typ, status, approval string) *model.WorkflowRunStepState {
+		return &model.WorkflowRunStepState{Type: typ, Status: status, Approval: approval}
+	}
+
+	require.True(t, isPendingApproval(step("approval", "waiting", "waiting")))
+	require.True(t, isPendingApproval(step("approval", "waiting", "")))
+
+	require.False(t, isPendingApproval(step("approval", "waiting", "approved")))
+	require.False(t, isPendingApproval(step("approval", "success", "approved")))
+	require.False(t, isPendingApproval(step("approval", "failure", "rejected")))
+	require.False(t, isPendingApproval(step("container", "waiting", "")))
+	require.False(t, isPendingApproval(nil))
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/puppetlabs/relay/pkg/debug"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/spf13/cobra"
+)
+
+const exportIndexFileName = "index.json"
+
+type workflowExportIndex struct {
+	Context    string                 `json:"context"`
+	ExportedAt time.Time              `json:"exported_at"`
+	Workflows  []*workflowExportEntry `json:"workflows"`
+}
+
+type workflowExportEntry struct {
+	Name     string `json:"name"`
+	Revision string `json:"revision,omitempty"`
+	File     string `json:"file,omitempty"`
+}
+
+func newExportWorkflowsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Download the latest revision of every workflow to a directory",
+		Long: `Download the latest revision of every workflow to a directory.
+
+Each workflow is written to <name>.yaml, and an index.json file records the
+revision each file was exported from.`,
+		Args: cobra.NoArgs,
+		RunE: doExportWorkflows,
+	}
+
+	cmd.Flags().String("dir", "workflows", "Directory to write workflow files to")
+
+	return cmd
+}
+
+func doExportWorkflows(cmd *cobra.Command, args []string) error {
+	dir, ferr := cmd.Flags().GetString("dir")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.NewWorkflowExportDirCreateError(dir).WithCause(err)
+	}
+
+	Dialog.Progress("Fetching workflows...")
+
+	req := Client.Api.ViewsApi.GetWorkflowsView(cmd.Context())
+	wv, _, err := Client.Api.ViewsApi.GetWorkflowsViewExecute(req)
+	if err != nil {
+		debug.Logf("failed to list workflows: %s", err.Error())
+		return err
+	}
+
+	index := &workflowExportIndex{
+		Context:    Config.CurrentContext,
+		ExportedAt: time.Now().UTC(),
+	}
+
+	exported := 0
+
+	for _, workflow := range wv.Workflows {
+		file, ok := exportFileName(workflow.Name)
+		if !ok {
+			Dialog.Warnf("Workflow name %q cannot be used as a file name; skipping", workflow.Name)
+			continue
+		}
+
+		entry := &workflowExportEntry{Name: workflow.Name}
+		index.Workflows = append(index.Workflows, entry)
+
+		rev, err := Client.GetLatestRevision(workflow.Name)
+		if err != nil {
+			if errors.IsClientResponseNotFound(err) {
+				Dialog.Warnf("Workflow %s has no revisions; skipping", workflow.Name)
+				continue
+			}
+
+			return err
+		}
+
+		raw, err := decodeRevision(rev.Revision)
+		if err != nil {
+			return err
+		}
+
+		entry.Revision = revisionID(model.RevisionSummary{RevisionIdentifier: rev.Revision.RevisionIdentifier})
+		entry.File = file
+
+		path := filepath.Join(dir, entry.File)
+		if err := ioutil.WriteFile(path, []byte(raw), 0644); err != nil {
+			return errors.NewWorkflowExportFileWriteError(path).WithCause(err)
+		}
+
+		exported++
+	}
+
+	indexBytes, _ := json.MarshalIndent(index, "", "  ")
+
+	path := filepath.Join(dir, exportIndexFileName)
+	if err := ioutil.WriteFile(path, append(indexBytes, '\n'), 0644); err != nil {
+		return errors.NewWorkflowExportFileWriteError(path).WithCause(err)
+	}
+
+	Dialog.Infof("Exported %d workflows to %s", exported, dir)
+
+	return nil
+}
+
+// exportFileName returns the name of the file a workflow is exported to. Names
+// that could resolve outside the export directory are rejected.
+func exportFileName(name string) (string, bool) {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) || filepath.Base(name) != name {
+		return "", false
+	}
+
+	return name + ".yaml", true
+}
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportFileName(t *testing.T) {
+	file, ok := exportFileName("my-workflow")
+	require.True(t, ok)
+	require.Equal(t, "my-workflow.yaml", file)
+
+	for _, name := range []string{"", ".", "..", "../escape", "nested/name", `windows\name`} {
+		_, ok := exportFileName(name)
+		require.False(t, ok, name)
+	}
+}
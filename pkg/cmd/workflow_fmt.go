@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/format"
+	"github.com/spf13/cobra"
+)
+
+func newFmtWorkflowFileCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fmt [file...]",
+		Short: "Format local Relay workflow files",
+		Long: `Format local Relay workflow files with consistent key ordering, indentation
+and quoting. Comments are preserved.
+
+By default the formatted files are printed. Use --write to update the files in
+place, or --check to fail if any file is not formatted.`,
+		RunE: doFmtWorkflowFile,
+	}
+
+	cmd.Flags().StringP("file", "f", "", "Path to Relay workflow file")
+	cmd.Flags().Bool("check", false, "List files that are not formatted and exit non-zero if there are any")
+	cmd.Flags().Bool("write", false, "Write the formatted files in place")
+
+	return cmd
+}
+
+func doFmtWorkflowFile(cmd *cobra.Command, args []string) error {
+	file, ferr := cmd.Flags().GetString("file")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	check, ferr := cmd.Flags().GetBool("check")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	write, ferr := cmd.Flags().GetBool("write")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	paths := args
+	if file != "" {
+		paths = append([]string{file}, paths...)
+	}
+
+	if len(paths) == 0 {
+		return errors.NewWorkflowMissingFileFlagError()
+	}
+
+	unformatted := 0
+
+	for _, path := range paths {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return errors.NewWorkflowWorkflowFileReadError().WithCause(err)
+		}
+
+		formatted, err := format.Workflow(content)
+		if err != nil {
+			return errors.NewWorkflowWorkflowFileDecodeError(path).WithCause(err)
+		}
+
+		changed := !bytes.Equal(content, formatted)
+
+		switch {
+		case check:
+			if changed {
+				unformatted++
+				Dialog.WriteString(path + "\n")
+			}
+		case write:
+			if !changed {
+				continue
+			}
+
+			info, err := os.Stat(path)
+			if err != nil {
+				return errors.NewWorkflowWorkflowFileWriteError(path).WithCause(err)
+			}
+
+			if err := ioutil.WriteFile(path, formatted, info.Mode()); err != nil {
+				return errors.NewWorkflowWorkflowFileWriteError(path).WithCause(err)
+			}
+
+			Dialog.Infof("Formatted %s", path)
+		default:
+			Dialog.WriteString(string(formatted))
+		}
+	}
+
+	if unformatted > 0 {
+		return errors.NewWorkflowFmtCheckFailedError(fmt.Sprintf("%d", unformatted))
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/graph"
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/spf13/cobra"
+)
+
+func newGraphWorkflowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "graph [workflow name]",
+		Short: "Draw the steps of a Relay workflow and the dependencies between them",
+		Long: `Draw the steps of a Relay workflow and the dependencies between them.
+
+Draws a local workflow file when --file is given, or otherwise a revision of a
+workflow saved to Relay. Dependencies declared with dependsOn are drawn as solid
+edges, and outputs passed between steps as dashed edges labelled with the output
+name.`,
+		Args:              cobra.MaximumNArgs(1),
+		RunE:              doGraphWorkflow,
+		ValidArgsFunction: doListWorkflowsCompletion,
+	}
+
+	cmd.Flags().StringP("file", "f", "", "Path to a local Relay workflow file to draw")
+	cmd.Flags().String("revision", "", "ID of the revision to draw instead of the latest revision")
+	cmd.Flags().String("format", graph.FormatASCII, "Diagram format: (mermaid|dot|ascii)")
+
+	return cmd
+}
+
+func doGraphWorkflow(cmd *cobra.Command, args []string) error {
+	format, ferr := cmd.Flags().GetString("format")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	if _, ok := graph.Render(&graph.Graph{}, format); !ok {
+		return errors.NewWorkflowInvalidGraphFormatError(format)
+	}
+
+	var steps []*model.WorkflowStep
+
+	if cmd.Flags().Changed("file") {
+		path, content, err := readFile(cmd)
+		if err != nil {
+			return err
+		}
+
+		var derr error
+		steps, derr = graph.StepsFromFile([]byte(content))
+		if derr != nil {
+			return errors.NewWorkflowWorkflowFileDecodeError(path).WithCause(derr)
+		}
+	} else {
+		revision, ferr := cmd.Flags().GetString("revision")
+		if ferr != nil {
+			return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+		}
+
+		name, err := getWorkflowName(args)
+		if err != nil {
+			return err
+		}
+
+		var rev *model.RevisionEntity
+		if revision == "" {
+			rev, err = Client.GetLatestRevision(name)
+		} else {
+			rev, err = Client.GetRevision(name, revision)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		steps = rev.Revision.Steps
+	}
+
+	out, _ := graph.Render(graph.New(steps), format)
+
+	// The diagram is the output of the command in every output type, so it is
+	// written directly to stdout.
+	fmt.Fprint(os.Stdout, out)
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/puppetlabs/relay/pkg/config"
+	"github.com/puppetlabs/relay/pkg/dialog"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/lint"
+	"github.com/puppetlabs/relay/pkg/version"
+	"github.com/spf13/cobra"
+)
+
+func newLintWorkflowFileCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint [file...]",
+		Short: "Check local Relay workflow files for problems without contacting Relay",
+		Long: `Check local Relay workflow files for problems without contacting Relay.
+
+Each problem is reported with the line and column it was found at. The command
+exits non-zero if any errors are found; warnings are reported but do not cause
+a failure.`,
+		RunE: doLintWorkflowFile,
+	}
+
+	cmd.Flags().StringP("file", "f", "", "Path to Relay workflow file")
+	cmd.Flags().String("format", "", "Format of reported findings: (text|json|sarif) (default text, or json with --out json)")
+
+	return cmd
+}
+
+func doLintWorkflowFile(cmd *cobra.Command, args []string) error {
+	file, ferr := cmd.Flags().GetString("file")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	paths := args
+	if file != "" {
+		paths = append([]string{file}, paths...)
+	}
+
+	if len(paths) == 0 {
+		return errors.NewWorkflowMissingFileFlagError()
+	}
+
+	format, err := getFindingsFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	var results []*lint.Result
+
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return errors.NewWorkflowWorkflowFileReadError().WithCause(err)
+		}
+
+		findings, err := lint.Lint(cmd.Context(), f)
+		f.Close()
+
+		if err != nil {
+			return errors.NewWorkflowWorkflowFileReadError().WithCause(err)
+		}
+
+		results = append(results, &lint.Result{File: path, Findings: findings})
+	}
+
+	if err := outputFindings(format, results); err != nil {
+		return err
+	}
+
+	if errs := countErrorFindings(results); errs > 0 {
+		return errors.NewWorkflowLintFailedError(fmt.Sprintf("%d", errs))
+	}
+
+	findingsDialog(format).Infof("No errors found in %d workflow files", len(paths))
+
+	return nil
+}
+
+const (
+	findingsFormatText  = "text"
+	findingsFormatJSON  = "json"
+	findingsFormatSARIF = "sarif"
+)
+
+func getFindingsFormat(cmd *cobra.Command) (string, errors.Error) {
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return "", errors.NewGeneralUnknownError().WithCause(err).Bug()
+	}
+
+	switch format {
+	case "":
+		if Config.Out == config.OutputTypeJSON {
+			return findingsFormatJSON, nil
+		}
+
+		return findingsFormatText, nil
+	case findingsFormatText, findingsFormatJSON, findingsFormatSARIF:
+		return format, nil
+	}
+
+	return "", errors.NewWorkflowInvalidFindingsFormatError(format)
+}
+
+// outputFindings writes the findings for each file in the given format. The
+// structured formats are written directly to stdout so that they are produced
+// regardless of the output type.
+func outputFindings(format string, results []*lint.Result) errors.Error {
+	var err error
+
+	switch format {
+	case findingsFormatJSON:
+		err = lint.WriteJSON(os.Stdout, results)
+	case findingsFormatSARIF:
+		err = lint.WriteSARIF(os.Stdout, results, version.GetVersion())
+	default:
+		for _, result := range results {
+			for _, finding := range result.Findings {
+				Dialog.WriteString(fmt.Sprintf("%s:%d:%d: %s: %s [%s]\n", result.File, finding.Line, finding.Column, finding.Severity, finding.Message, finding.Rule))
+			}
+		}
+	}
+
+	if err != nil {
+		return errors.NewGeneralUnknownError().WithCause(err)
+	}
+
+	return nil
+}
+
+// findingsDialog returns the dialog to report status with. When findings are
+// written to stdout in a structured format, status goes to stderr instead so
+// that stdout can be parsed.
+func findingsDialog(format string) dialog.Dialog {
+	if format == findingsFormatText {
+		return Dialog
+	}
+
+	return Dialog.WithStdout(os.Stderr)
+}
+
+func countErrorFindings(results []*lint.Result) int {
+	errs := 0
+
+	for _, result := range results {
+		for _, finding := range result.Findings {
+			if finding.Severity == lint.SeverityError {
+				errs++
+			}
+		}
+	}
+
+	return errs
+}
+
+// validationFindings converts an error returned by the validation API into
+// findings, placing each one at the part of the file it refers to where
+// possible.
+func validationFindings(content string, err errors.Error) []*lint.Finding {
+	var findings []*lint.Finding
+
+	var collect func(err errors.Error, path string)
+	collect = func(err errors.Error, path string) {
+		items, ok := err.Items()
+		if len(err.Causes()) == 0 && (!ok || len(items) == 0) {
+			line, column := lint.Locate([]byte(content), path)
+
+			findings = append(findings, &lint.Finding{
+				Rule:     err.Code(),
+				Severity: lint.SeverityError,
+				Message:  err.FormattedDescription().Friendly(),
+				Line:     line,
+				Column:   column,
+			})
+
+			return
+		}
+
+		for _, cause := range err.Causes() {
+			collect(cause, path)
+		}
+
+		keys := make([]string, 0, len(items))
+		for key := range items {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			collect(items[key], strings.Trim(path+"."+key, "."))
+		}
+	}
+
+	collect(err, "")
+
+	return findings
+}
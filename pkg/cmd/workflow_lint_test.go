@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/puppetlabs/errawr-go/v2/pkg/encoding"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintWorkflowFileStructuredOutput(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "ok.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`apiVersion: v1
+steps:
+- name: hello
+  image: alpine:latest
+`), 0644))
+
+	for _, format := range []string{findingsFormatJSON, findingsFormatSARIF} {
+		t.Run(format, func(t *testing.T) {
+			out, err := os.Create(filepath.Join(dir, "out."+format))
+			require.NoError(t, err)
+			defer out.Close()
+
+			stdout := os.Stdout
+			os.Stdout = out
+			defer func() { os.Stdout = stdout }()
+
+			_, _, err = ExecuteCommand("relay workflow lint -f " + path + " --format " + format)
+			os.Stdout = stdout
+			require.NoError(t, err)
+
+			b, err := ioutil.ReadFile(out.Name())
+			require.NoError(t, err)
+
+			var doc interface{}
+			require.NoError(t, json.Unmarshal(b, &doc), string(b))
+		})
+	}
+}
+
+func TestValidateWorkflowFileStructuredOutput(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "workflow.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`apiVersion: v1
+steps:
+- name: hello
+`), 0644))
+
+	rejected, err := json.Marshal(map[string]interface{}{
+		"error": encoding.ForDisplay(errors.NewWorkflowMissingStepNameError()),
+	})
+	require.NoError(t, err)
+
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write(rejected)
+	}))
+	defer rejecting.Close()
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	validate := func(t *testing.T, apiDomain string) (string, error) {
+		cfg := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, ioutil.WriteFile(cfg, []byte("contexts:\n  test:\n    apiDomain: "+apiDomain+"\n"), 0644))
+
+		out, err := os.Create(filepath.Join(t.TempDir(), "out.json"))
+		require.NoError(t, err)
+		defer out.Close()
+
+		stdout := os.Stdout
+		os.Stdout = out
+		defer func() { os.Stdout = stdout }()
+
+		_, _, err = ExecuteCommand("relay workflow validate -c " + cfg + " -x test -f " + path + " --format json")
+		os.Stdout = stdout
+
+		b, rerr := ioutil.ReadFile(out.Name())
+		require.NoError(t, rerr)
+
+		return string(b), err
+	}
+
+	t.Run("rejected", func(t *testing.T) {
+		out, err := validate(t, rejecting.URL)
+		e, ok := err.(errors.Error)
+		require.True(t, ok, "%+v", err)
+		require.True(t, errors.IsWorkflowLintFailedError(e), "%+v", err)
+		require.Contains(t, out, errors.NewWorkflowMissingStepNameError().Code())
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		out, err := validate(t, unreachable.URL)
+		e, ok := err.(errors.Error)
+		require.True(t, ok, "%+v", err)
+		require.True(t, errors.IsClientRequestError(e), "%+v", err)
+		require.Empty(t, out)
+	})
+}
@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/puppetlabs/relay/pkg/client"
+	"github.com/puppetlabs/relay/pkg/config"
+	"github.com/puppetlabs/relay/pkg/debug"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/spf13/cobra"
+)
+
+const approvalStepType = "approval"
+
+func newWorkflowLogsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs [workflow name] [run number]",
+		Short: "Print the step logs of a Relay workflow run",
+		Args:  cobra.MaximumNArgs(2),
+		RunE:  doWorkflowLogs,
+	}
+
+	cmd.Flags().StringP("step", "s", "", "Only print the logs of the named step")
+	cmd.Flags().Bool("follow", false, "Keep streaming logs until the run finishes")
+	cmd.Flags().Bool("receipt-times", false, "Prefix each line with the time the CLI received it, as logs carry no timestamps of their own")
+
+	return cmd
+}
+
+func doWorkflowLogs(cmd *cobra.Command, args []string) error {
+	name, err := getWorkflowName(args)
+	if err != nil {
+		return err
+	}
+
+	runNumber, err := getRunNumber(args)
+	if err != nil {
+		return err
+	}
+
+	step, ferr := cmd.Flags().GetString("step")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	follow, ferr := cmd.Flags().GetBool("follow")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	receiptTimes, ferr := cmd.Flags().GetBool("receipt-times")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	lw := &stepLogPrinter{receiptTimes: receiptTimes}
+
+	if step != "" {
+		return printStepLog(lw, name, runNumber, step, follow)
+	}
+
+	if follow {
+		return followRunLogs(lw, name, runNumber)
+	}
+
+	resp, err := Client.GetWorkflowRun(name, runNumber)
+	if err != nil {
+		return err
+	}
+
+	if resp.Run.State == nil {
+		return nil
+	}
+
+	for _, stepName := range stepsByStartTime(resp.Run.State.Steps) {
+		if resp.Run.State.Steps[stepName].Type == approvalStepType {
+			continue
+		}
+
+		if err := printStepLog(lw, name, runNumber, stepName, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// followRunLogs streams the logs of every step in a run, starting to follow
+// each step as soon as it begins executing, until the run is finished.
+func followRunLogs(lw *stepLogPrinter, name string, runNumber int) error {
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		firstErr  error
+		following = make(map[string]bool)
+	)
+
+	for {
+		resp, err := Client.GetWorkflowRun(name, runNumber)
+		if err != nil {
+			return err
+		}
+
+		state := resp.Run.State
+		if state == nil {
+			state = &model.WorkflowRunState{}
+		}
+
+		for _, stepName := range stepsByStartTime(state.Steps) {
+			step := state.Steps[stepName]
+			if following[stepName] || step.StartedAt == nil || step.Type == approvalStepType {
+				continue
+			}
+
+			following[stepName] = true
+
+			wg.Add(1)
+			go func(stepName string) {
+				defer wg.Done()
+
+				if err := printStepLog(lw, name, runNumber, stepName, true); err != nil {
+					mu.Lock()
+					defer mu.Unlock()
+
+					if firstErr == nil {
+						firstErr = err
+					}
+				}
+			}(stepName)
+		}
+
+		if client.IsTerminalRunStatus(state.Status) {
+			break
+		}
+
+		time.Sleep(watchPollInterval)
+	}
+
+	wg.Wait()
+
+	return firstErr
+}
+
+func printStepLog(lw *stepLogPrinter, name string, runNumber int, step string, follow bool) errors.Error {
+	w := lw.forStep(step)
+
+	err := Client.GetWorkflowRunStepLog(name, runNumber, step, follow, w)
+	w.Flush()
+
+	if err != nil {
+		if errors.IsClientResponseNotFound(err) {
+			debug.Logf("no log found for step %s", step)
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// stepsByStartTime orders step names by the time each step started, placing
+// steps that have not started last.
+func stepsByStartTime(steps map[string]*model.WorkflowRunStepState) []string {
+	names := sortedStepNames(steps)
+
+	sort.SliceStable(names, func(i, j int) bool {
+		a, b := steps[names[i]].StartedAt, steps[names[j]].StartedAt
+
+		switch {
+		case a == nil:
+			return false
+		case b == nil:
+			return true
+		}
+
+		return a.Before(*b)
+	})
+
+	return names
+}
+
+type stepLogLine struct {
+	Step       string     `json:"step"`
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+	Line       string     `json:"line"`
+}
+
+// stepLogPrinter serializes log lines from one or more steps to the output,
+// prefixing each with the name of the step it came from.
+type stepLogPrinter struct {
+	mu           sync.Mutex
+	receiptTimes bool
+}
+
+func (p *stepLogPrinter) forStep(step string) *stepLogWriter {
+	return &stepLogWriter{p: p, step: step}
+}
+
+func (p *stepLogPrinter) printLine(step, line string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// The log API streams raw output, so the only time available for a line
+	// is when it was received.
+	now := time.Now()
+
+	if Config.Out == config.OutputTypeJSON {
+		entry := stepLogLine{Step: step, Line: line}
+		if p.receiptTimes {
+			entry.ReceivedAt = &now
+		}
+
+		json.NewEncoder(os.Stdout).Encode(entry)
+		return
+	}
+
+	prefix := fmt.Sprintf("[%s] ", step)
+	if p.receiptTimes {
+		prefix += now.Format(time.RFC3339) + " "
+	}
+
+	Dialog.WriteString(prefix + line + "\n")
+}
+
+// stepLogWriter buffers a raw log stream and hands complete lines to the
+// printer.
+type stepLogWriter struct {
+	p    *stepLogPrinter
+	step string
+	buf  []byte
+}
+
+func (w *stepLogWriter) Write(b []byte) (int, error) {
+	w.buf = append(w.buf, b...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+
+		w.p.printLine(w.step, string(bytes.TrimSuffix(w.buf[:i], []byte("\r"))))
+		w.buf = w.buf[i+1:]
+	}
+
+	return len(b), nil
+}
+
+func (w *stepLogWriter) Flush() {
+	if len(w.buf) > 0 {
+		w.p.printLine(w.step, string(w.buf))
+		w.buf = nil
+	}
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/puppetlabs/relay/pkg/debug"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// getRunParameters combines the values read from a parameters file, if one is
+// given, with key=value parameters from the command line. Command line values
+// take precedence.
+func getRunParameters(paramsFile string, params []string) (map[string]interface{}, errors.Error) {
+	values := make(map[string]interface{})
+
+	if paramsFile != "" {
+		fileValues, err := readParametersFile(paramsFile)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, val := range fileValues {
+			values[key] = val
+		}
+	}
+
+	overrides, err := parseParameterValues(params)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, val := range overrides {
+		values[key] = val
+	}
+
+	return values, nil
+}
+
+// readParametersFile reads a YAML or JSON object of parameter values. Values
+// keep their types, so lists, objects, numbers and booleans are passed to the
+// run as-is.
+func readParametersFile(path string) (map[string]interface{}, errors.Error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.NewWorkflowParametersFileReadError(path).WithCause(err)
+	}
+
+	values := make(map[string]interface{})
+
+	// JSON is a subset of YAML, so a single decoder handles both formats.
+	if err := yaml.Unmarshal(b, &values); err != nil {
+		return nil, errors.NewWorkflowParametersFileDecodeError(path).WithCause(err)
+	}
+
+	return values, nil
+}
+
+// validateRunParameters checks parameter values against the parameters
+// declared by the latest revision of the workflow, reporting any defaults that
+// will be used. Workflows without a revision are not checked.
+func validateRunParameters(name string, values map[string]interface{}) errors.Error {
+	rev, err := Client.GetLatestRevision(name)
+	if err != nil {
+		if errors.IsClientResponseNotFound(err) {
+			debug.Logf("no revision found for workflow %s; skipping parameter validation", name)
+			return nil
+		}
+
+		return err
+	}
+
+	declared := rev.Revision.Parameters
+
+	unknown, missing := declared.Check(values)
+	if len(unknown) > 0 {
+		return errors.NewWorkflowUnknownParametersError(strings.Join(unknown, ", "))
+	}
+
+	if len(missing) > 0 {
+		return errors.NewWorkflowMissingParametersError(strings.Join(missing, ", "))
+	}
+
+	names := make([]string, 0, len(declared))
+	for name := range declared {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, ok := values[name]; !ok {
+			Dialog.Infof("Using default value for parameter %s: %s", name, formatValue(declared[name].Default))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/format"
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/spf13/cobra"
+)
+
+// pushRunPollInterval is how often the runs of a workflow are checked for the
+// run started by a pushed event.
+const pushRunPollInterval = 2 * time.Second
+
+func newPushWorkflowEventCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "push [workflow name]",
+		Short: "Send an event to the push trigger of a Relay workflow",
+		Long: `Send an event to the push trigger of a Relay workflow.
+
+The event data is checked against the schema of the trigger before it is sent,
+and the event is authenticated with the push token of the trigger. The command
+then waits for the run started by the event and prints its run number.
+
+Event data is a JSON object given to --data directly, or read from a file with
+--data @path or from stdin with --data @-.`,
+		Args:              cobra.MaximumNArgs(1),
+		RunE:              doPushWorkflowEvent,
+		ValidArgsFunction: doListWorkflowsCompletion,
+	}
+
+	cmd.Flags().String("trigger", "", "Name of the push trigger (required if the workflow has more than one)")
+	cmd.Flags().String("data", "", "Event data as JSON, @path to read a file or @- to read stdin")
+	cmd.Flags().String("key", "", "Optional key for the event, used to deduplicate events")
+	cmd.Flags().Duration("wait", 30*time.Second, "How long to wait for the run started by the event (0 to not wait)")
+
+	return cmd
+}
+
+func doPushWorkflowEvent(cmd *cobra.Command, args []string) error {
+	triggerName, ferr := cmd.Flags().GetString("trigger")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	dataFlag, ferr := cmd.Flags().GetString("data")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	key, ferr := cmd.Flags().GetString("key")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	wait, ferr := cmd.Flags().GetDuration("wait")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	name, err := getWorkflowName(args)
+	if err != nil {
+		return err
+	}
+
+	data, err := readPushData(dataFlag)
+	if err != nil {
+		return err
+	}
+
+	Dialog.Progress("Fetching workflow triggers...")
+
+	rev, err := Client.GetLatestRevision(name)
+	if err != nil {
+		return err
+	}
+
+	trigger, err := selectTrigger(name, rev.Revision.Triggers, "push", triggerName)
+	if err != nil {
+		return err
+	}
+
+	problems, verr := trigger.Source.PushWorkflowTriggerSource.ValidateData(data)
+	if verr != nil {
+		return errors.NewGeneralUnknownError().WithCause(verr)
+	}
+
+	if len(problems) > 0 {
+		return errors.NewWorkflowPushDataInvalidError(trigger.Name, strings.Join(problems, "; "))
+	}
+
+	wf, err := Client.GetWorkflow(name)
+	if err != nil {
+		return err
+	}
+
+	state := triggerState(wf.Workflow, trigger.Name)
+	if state == nil || state.Push == nil || state.Push.Token == "" {
+		return errors.NewWorkflowPushTriggerTokenUnavailableError(trigger.Name)
+	}
+
+	Dialog.Progress("Sending event...")
+
+	event, err := Client.CreateEvent(state.Push.Token, data, key)
+	if err != nil {
+		return err
+	}
+
+	if wait <= 0 || event.Event == nil {
+		Dialog.Infof("Event sent to trigger %s of workflow %s", trigger.Name, name)
+		return nil
+	}
+
+	Dialog.Progress("Waiting for the workflow run to start...")
+
+	for deadline := time.Now().Add(wait); ; {
+		resp, err := Client.ListWorkflowRuns(name)
+		if err != nil {
+			return err
+		}
+
+		for _, run := range resp.Runs {
+			if run.CreatedBy != nil && run.CreatedBy.Event != nil && run.CreatedBy.Event.ID == event.Event.ID {
+				Dialog.Infof(`Event sent to trigger %s started run %d
+
+View more information or update workflow settings at: %v`,
+					trigger.Name,
+					run.RunNumber,
+					format.GuiLink(Config, "/workflows/%s/runs/%d/graph", name, run.RunNumber),
+				)
+
+				return nil
+			}
+		}
+
+		if time.Now().Add(pushRunPollInterval).After(deadline) {
+			break
+		}
+
+		time.Sleep(pushRunPollInterval)
+	}
+
+	Dialog.Infof("Event %s sent to trigger %s, but no run started within %s", event.Event.ID, trigger.Name, wait)
+
+	return nil
+}
+
+// readPushData reads event data given directly, from a file with @path or
+// from stdin with @-. No data is an empty event.
+func readPushData(flag string) (map[string]interface{}, errors.Error) {
+	raw := []byte(flag)
+
+	switch {
+	case flag == "":
+		return map[string]interface{}{}, nil
+	case flag == "@-":
+		b, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, errors.NewWorkflowPushDataReadError().WithCause(err)
+		}
+
+		raw = b
+	case strings.HasPrefix(flag, "@"):
+		b, err := ioutil.ReadFile(flag[1:])
+		if err != nil {
+			return nil, errors.NewWorkflowPushDataReadError().WithCause(err)
+		}
+
+		raw = b
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(raw, &data); err != nil || data == nil {
+		return nil, errors.NewWorkflowPushDataDecodeError().WithCause(err)
+	}
+
+	return data, nil
+}
+
+// selectTrigger finds the trigger of the given type with the given name, or
+// the only trigger of that type if no name is given.
+func selectTrigger(workflow string, triggers []*model.WorkflowTrigger, typ, name string) (*model.WorkflowTrigger, errors.Error) {
+	var matching []*model.WorkflowTrigger
+
+	for _, trigger := range triggers {
+		if trigger == nil || trigger.Source == nil || trigger.Source.Type != typ {
+			continue
+		}
+
+		if name != "" && trigger.Name == name {
+			return trigger, nil
+		}
+
+		matching = append(matching, trigger)
+	}
+
+	switch {
+	case name != "":
+		return nil, errors.NewWorkflowTriggerNotFoundError(workflow, typ, name)
+	case len(matching) == 0:
+		return nil, errors.NewWorkflowNoTriggersError(workflow, typ)
+	case len(matching) > 1:
+		names := make([]string, len(matching))
+		for i, trigger := range matching {
+			names[i] = trigger.Name
+		}
+
+		return nil, errors.NewWorkflowMultipleTriggersError(workflow, typ, strings.Join(names, ", "))
+	}
+
+	return matching[0], nil
+}
+
+// triggerState returns the state of the named trigger of a workflow.
+func triggerState(wf *model.Workflow, trigger string) *model.WorkflowTriggerSourceState {
+	if wf == nil || wf.State == nil {
+		return nil
+	}
+
+	for _, state := range wf.State.Triggers {
+		if state.Name == trigger {
+			return state.Source
+		}
+	}
+
+	return nil
+}
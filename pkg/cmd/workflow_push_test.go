@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectTrigger(t *testing.T) {
+	trigger := func(name, typ string) *model.WorkflowTrigger {
+		return &model.WorkflowTrigger{Name: name, Source: &model.WorkflowTriggerSource{Type: typ}}
+	}
+
+	schedule := trigger("nightly", "schedule")
+	build := trigger("build", "push")
+	deploy := trigger("deploy", "push")
+
+	selected, err := selectTrigger("wf", []*model.WorkflowTrigger{schedule, build}, "push", "")
+	require.Nil(t, err)
+	require.Equal(t, build, selected)
+
+	selected, err = selectTrigger("wf", []*model.WorkflowTrigger{schedule, build, deploy}, "push", "deploy")
+	require.Nil(t, err)
+	require.Equal(t, deploy, selected)
+
+	_, err = selectTrigger("wf", []*model.WorkflowTrigger{schedule, build, deploy}, "push", "")
+	require.NotNil(t, err)
+
+	_, err = selectTrigger("wf", []*model.WorkflowTrigger{schedule}, "push", "")
+	require.NotNil(t, err)
+
+	_, err = selectTrigger("wf", []*model.WorkflowTrigger{schedule, build}, "push", "nightly")
+	require.NotNil(t, err)
+}
+
+func TestReadPushData(t *testing.T) {
+	data, err := readPushData(`{"branch": "main", "count": 2}`)
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{"branch": "main", "count": float64(2)}, data)
+
+	data, err = readPushData("")
+	require.Nil(t, err)
+	require.Empty(t, data)
+
+	_, err = readPushData(`["not", "an", "object"]`)
+	require.NotNil(t, err)
+}
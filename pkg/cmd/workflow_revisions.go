@@ -0,0 +1,301 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/puppetlabs/relay/pkg/config"
+	"github.com/puppetlabs/relay/pkg/debug"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/spf13/cobra"
+)
+
+func newWorkflowRevisionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revisions",
+		Short: "Browse the revision history of your Relay workflows",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(newListWorkflowRevisionsCommand())
+	cmd.AddCommand(newGetWorkflowRevisionCommand())
+	cmd.AddCommand(newDiffWorkflowRevisionsCommand())
+
+	return cmd
+}
+
+func newListWorkflowRevisionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [workflow name]",
+		Short: "List the revisions of a Relay workflow",
+		Long: `List the revisions of a Relay workflow, newest first.
+
+The Relay API has no revision listing, so this lists the latest revision and
+every revision used by one of the runs of the workflow. Revisions that were saved
+but never run, other than the latest, are not shown.
+
+The author is taken from the source of each revision: the repository and commit
+for revisions synced from a repository, or otherwise the source type, such as
+relay for revisions saved in Relay.`,
+		Args:              cobra.MaximumNArgs(1),
+		RunE:              doListWorkflowRevisions,
+		ValidArgsFunction: doListWorkflowsCompletion,
+	}
+
+	return cmd
+}
+
+func doListWorkflowRevisions(cmd *cobra.Command, args []string) error {
+	name, err := getWorkflowName(args)
+	if err != nil {
+		return err
+	}
+
+	Dialog.Progress("Fetching revisions...")
+
+	revs, latest, err := Client.ListWorkflowRevisions(name)
+	if err != nil {
+		debug.Logf("failed to list workflow revisions: %s", err.Error())
+		return err
+	}
+
+	t := Dialog.Table()
+
+	t.Headers([]string{"Revision", "Created", "Author", "Steps", "Latest"})
+
+	for _, rev := range revs {
+		id := revisionID(model.RevisionSummary{RevisionIdentifier: rev.RevisionIdentifier})
+
+		current := ""
+		if id == latest {
+			current = "yes"
+		}
+
+		t.AppendRow([]string{
+			id,
+			formatTime(rev.CreatedAT),
+			rev.Author(),
+			fmt.Sprintf("%d", len(rev.Steps)),
+			current,
+		})
+	}
+
+	t.Flush()
+
+	return nil
+}
+
+func newGetWorkflowRevisionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [workflow name] [revision id]",
+		Short: "Print the workflow file of a Relay workflow revision",
+		Args:  cobra.MaximumNArgs(2),
+		RunE:  doGetWorkflowRevision,
+	}
+
+	return cmd
+}
+
+func doGetWorkflowRevision(cmd *cobra.Command, args []string) error {
+	name, err := getWorkflowName(args)
+	if err != nil {
+		return err
+	}
+
+	id, err := getRevisionID(args, 1, "Revision ID")
+	if err != nil {
+		return err
+	}
+
+	rev, err := Client.GetRevision(name, id)
+	if err != nil {
+		return err
+	}
+
+	if Config.Out == config.OutputTypeJSON {
+		rev.OutputJSON()
+		return nil
+	}
+
+	raw, rerr := decodeRevision(rev.Revision)
+	if rerr != nil {
+		return rerr
+	}
+
+	Dialog.WriteString(raw)
+
+	return nil
+}
+
+func newDiffWorkflowRevisionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [workflow name] [from revision id] [to revision id]",
+		Short: "Show the changes between two revisions of a Relay workflow",
+		Args:  cobra.MaximumNArgs(3),
+		RunE:  doDiffWorkflowRevisions,
+	}
+
+	cmd.Flags().IntP("context", "U", 3, "Number of lines of context to show around each change")
+
+	return cmd
+}
+
+type revisionDiffOutput struct {
+	From    string              `json:"from"`
+	To      string              `json:"to"`
+	Diff    string              `json:"diff"`
+	Changes *model.RevisionDiff `json:"changes"`
+}
+
+func doDiffWorkflowRevisions(cmd *cobra.Command, args []string) error {
+	context, ferr := cmd.Flags().GetInt("context")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	name, err := getWorkflowName(args)
+	if err != nil {
+		return err
+	}
+
+	fromID, err := getRevisionID(args, 1, "From revision ID")
+	if err != nil {
+		return err
+	}
+
+	toID, err := getRevisionID(args, 2, "To revision ID")
+	if err != nil {
+		return err
+	}
+
+	from, err := Client.GetRevision(name, fromID)
+	if err != nil {
+		return err
+	}
+
+	to, err := Client.GetRevision(name, toID)
+	if err != nil {
+		return err
+	}
+
+	fromRaw, rerr := decodeRevision(from.Revision)
+	if rerr != nil {
+		return rerr
+	}
+
+	toRaw, rerr := decodeRevision(to.Revision)
+	if rerr != nil {
+		return rerr
+	}
+
+	diff, err := unifiedRevisionDiff(fromID, fromRaw, toID, toRaw, context)
+	if err != nil {
+		return err
+	}
+
+	changes := model.DiffRevisions(from.Revision, to.Revision)
+
+	if Config.Out == config.OutputTypeJSON {
+		jsonBytes, _ := json.MarshalIndent(revisionDiffOutput{
+			From:    fromID,
+			To:      toID,
+			Diff:    diff,
+			Changes: changes,
+		}, "", "  ")
+
+		fmt.Println(string(jsonBytes))
+		return nil
+	}
+
+	if diff == "" {
+		Dialog.Info("The workflow files of these revisions are identical")
+		return nil
+	}
+
+	Dialog.WriteString(diff)
+	outputRevisionChanges(changes)
+
+	return nil
+}
+
+func unifiedRevisionDiff(fromID, fromRaw, toID, toRaw string, context int) (string, errors.Error) {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(fromRaw),
+		B:        difflib.SplitLines(toRaw),
+		FromFile: fromID,
+		ToFile:   toID,
+		Context:  context,
+	})
+	if err != nil {
+		return "", errors.NewGeneralUnknownError().WithCause(err)
+	}
+
+	return diff, nil
+}
+
+func outputRevisionChanges(changes *model.RevisionDiff) {
+	if changes.Empty() {
+		Dialog.Info("No steps, triggers or parameters were added or removed")
+		return
+	}
+
+	var summary []string
+	summary = append(summary, describeRevisionChanges("Steps", changes.Steps)...)
+	summary = append(summary, describeRevisionChanges("Triggers", changes.Triggers)...)
+	summary = append(summary, describeRevisionChanges("Parameters", changes.Parameters)...)
+
+	Dialog.Info(strings.Join(summary, "\n"))
+}
+
+func describeRevisionChanges(kind string, changes model.RevisionChanges) []string {
+	var lines []string
+
+	if len(changes.Added) > 0 {
+		lines = append(lines, fmt.Sprintf("%s added: %s", kind, strings.Join(changes.Added, ", ")))
+	}
+
+	if len(changes.Removed) > 0 {
+		lines = append(lines, fmt.Sprintf("%s removed: %s", kind, strings.Join(changes.Removed, ", ")))
+	}
+
+	return lines
+}
+
+func decodeRevision(rev *model.Revision) (string, errors.Error) {
+	raw, err := rev.DecodeRaw()
+	if err != nil {
+		debug.Logf("the workflow body was in the wrong format. %s", err.Error())
+		return "", errors.NewClientUnknownError().WithCause(err)
+	}
+
+	return raw, nil
+}
+
+// getRevisionID gets a revision ID from the argument at the given index. If
+// none is supplied, reads it from stdin using the given prompt.
+func getRevisionID(args []string, index int, prompt string) (string, errors.Error) {
+	if len(args) > index {
+		return args[index], nil
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+
+	fmt.Printf("%s: ", prompt)
+	idPrompt, err := reader.ReadString('\n')
+	if err != nil {
+		return "", errors.NewWorkflowRevisionIDReadError().WithCause(err)
+	}
+
+	id := strings.TrimSpace(idPrompt)
+
+	if id == "" {
+		return "", errors.NewWorkflowMissingRevisionIDError()
+	}
+
+	return id, nil
+}
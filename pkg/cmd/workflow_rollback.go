@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/puppetlabs/relay/pkg/debug"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/puppetlabs/relay/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+func newRollbackWorkflowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback [workflow name]",
+		Short: "Roll a Relay workflow back to a previous revision",
+		Long: `Roll a Relay workflow back to a previous revision by saving the workflow file
+of that revision as a new revision.
+
+Without --to, the workflow is rolled back --steps-back revisions from the latest
+one, as listed by "relay workflow revisions list". That list only has the latest
+revision and revisions used by a run, so revisions that were saved but never run
+are not counted and the rollback may go further back than expected. Use --to to
+roll back to an exact revision.`,
+		Args:              cobra.MaximumNArgs(1),
+		RunE:              doRollbackWorkflow,
+		ValidArgsFunction: doListWorkflowsCompletion,
+	}
+
+	cmd.Flags().String("to", "", "ID of the revision to roll back to")
+	cmd.Flags().Int("steps-back", 1, "Number of revisions to go back from the latest revision, counting only revisions used by a run")
+
+	return cmd
+}
+
+func doRollbackWorkflow(cmd *cobra.Command, args []string) error {
+	to, ferr := cmd.Flags().GetString("to")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	stepsBack, ferr := cmd.Flags().GetInt("steps-back")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	if to != "" && cmd.Flags().Changed("steps-back") {
+		return errors.NewWorkflowConflictingRollbackFlagsError()
+	}
+
+	if stepsBack < 1 {
+		return errors.NewWorkflowInvalidStepsBackError(fmt.Sprintf("%d", stepsBack))
+	}
+
+	name, err := getWorkflowName(args)
+	if err != nil {
+		return err
+	}
+
+	Dialog.Progress("Fetching revisions...")
+
+	latest, err := Client.GetLatestRevision(name)
+	if err != nil {
+		return err
+	}
+
+	latestID := revisionID(model.RevisionSummary{RevisionIdentifier: latest.Revision.RevisionIdentifier})
+
+	var target *model.Revision
+
+	if to != "" {
+		rev, err := Client.GetRevision(name, to)
+		if err != nil {
+			return err
+		}
+
+		target = rev.Revision
+	} else {
+		target, err = getPreviousRevision(name, latestID, stepsBack)
+		if err != nil {
+			return err
+		}
+
+		Dialog.Warn("Revisions that were saved but never run are not counted by --steps-back; check the changes below or use --to to pick an exact revision")
+	}
+
+	targetID := revisionID(model.RevisionSummary{RevisionIdentifier: target.RevisionIdentifier})
+
+	if targetID == latestID {
+		Dialog.Infof("Revision %s is already the latest revision of workflow %s", targetID, name)
+		return nil
+	}
+
+	latestRaw, err := decodeRevision(latest.Revision)
+	if err != nil {
+		return err
+	}
+
+	targetRaw, err := decodeRevision(target)
+	if err != nil {
+		return err
+	}
+
+	diff, err := unifiedRevisionDiff(latestID, latestRaw, targetID, targetRaw, 3)
+	if err != nil {
+		return err
+	}
+
+	if diff != "" {
+		Dialog.WriteString(diff)
+	}
+
+	outputRevisionChanges(model.DiffRevisions(latest.Revision, target))
+
+	proceed, err := util.Confirm(fmt.Sprintf("Are you sure you want to roll workflow %s back to revision %s?", name, targetID), Config)
+	if err != nil {
+		return err
+	}
+
+	if !proceed {
+		return nil
+	}
+
+	Dialog.Progress("Rolling back workflow...")
+
+	rev, err := Client.CreateRevision(name, targetRaw)
+	if err != nil {
+		debug.Logf("failed to create revision: %s", err.Error())
+		return err
+	}
+
+	Dialog.Infof("Workflow %s rolled back to revision %s as new revision %s",
+		name,
+		targetID,
+		revisionID(model.RevisionSummary{RevisionIdentifier: rev.Revision.RevisionIdentifier}),
+	)
+
+	return nil
+}
+
+// getPreviousRevision finds the revision the given number of steps older than
+// the latest revision, counting only the revisions used by a run.
+func getPreviousRevision(name, latestID string, stepsBack int) (*model.Revision, errors.Error) {
+	revs, _, err := Client.ListWorkflowRevisions(name)
+	if err != nil {
+		return nil, err
+	}
+
+	start := 0
+	for i, rev := range revs {
+		if rev.RevisionIdentifier != nil && rev.ID == latestID {
+			start = i
+			break
+		}
+	}
+
+	available := len(revs) - start - 1
+
+	if stepsBack > available {
+		return nil, errors.NewWorkflowRollbackRevisionNotFoundError(fmt.Sprintf("%d", stepsBack), fmt.Sprintf("%d", available))
+	}
+
+	return revs[start+stepsBack], nil
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/puppetlabs/relay/pkg/client"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/spf13/cobra"
+)
+
+// watchPollInterval is how often the run state is refreshed while watching a
+// workflow run.
+var watchPollInterval = 5 * time.Second
+
+// watchWorkflowRun polls a workflow run until it reaches a terminal state,
+// printing step status changes as they happen. An error is returned if the
+// run does not succeed or if the timeout (when non-zero) is exceeded.
+func watchWorkflowRun(cmd *cobra.Command, name string, runNumber int) error {
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return errors.NewGeneralUnknownError().WithCause(err).Bug()
+	}
+
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	Dialog.Infof("Watching run %d of workflow %s...", runNumber, name)
+
+	seen := make(map[string]string)
+
+	for {
+		resp, err := Client.GetWorkflowRun(name, runNumber)
+		if err != nil {
+			return err
+		}
+
+		state := resp.Run.State
+		if state == nil {
+			state = &model.WorkflowRunState{}
+		}
+
+		for _, stepName := range sortedStepNames(state.Steps) {
+			status := state.Steps[stepName].Status
+			if seen[stepName] != status {
+				Dialog.Infof("  %s: %s", stepName, status)
+				seen[stepName] = status
+			}
+		}
+
+		if client.IsTerminalRunStatus(state.Status) {
+			outputRunSteps(state)
+
+			if state.Status != client.RunStatusSuccess {
+				return errors.NewWorkflowRunFailedError(fmt.Sprintf("%d", runNumber), state.Status)
+			}
+
+			Dialog.Infof("Run %d finished with status %s", runNumber, state.Status)
+
+			return nil
+		}
+
+		wait := watchPollInterval
+		if !deadline.IsZero() {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return errors.NewWorkflowRunWatchTimeoutError(fmt.Sprintf("%d", runNumber), timeout.String())
+			} else if remaining < wait {
+				wait = remaining
+			}
+		}
+
+		time.Sleep(wait)
+	}
+}
+
+func outputRunSteps(state *model.WorkflowRunState) {
+	t := Dialog.Table()
+
+	t.Headers([]string{"Step", "Status", "Started", "Ended", "Duration"})
+
+	for _, stepName := range sortedStepNames(state.Steps) {
+		step := state.Steps[stepName]
+
+		t.AppendRow([]string{
+			stepName,
+			step.Status,
+			formatTime(step.StartedAt),
+			formatTime(step.EndedAt),
+			formatDuration(step.StartedAt, step.EndedAt),
+		})
+	}
+
+	t.Flush()
+}
+
+func sortedStepNames(steps map[string]*model.WorkflowRunStepState) []string {
+	names := make([]string, 0, len(steps))
+	for name := range steps {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Local().Format(time.RFC3339)
+}
+
+func formatDuration(start, end *time.Time) string {
+	if start == nil || end == nil {
+		return ""
+	}
+
+	return end.Sub(*start).Round(time.Second).String()
+}
@@ -0,0 +1,379 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/puppetlabs/relay/pkg/config"
+	"github.com/puppetlabs/relay/pkg/debug"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/format"
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/puppetlabs/relay/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+func newWorkflowRunsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "runs",
+		Short: "Manage your Relay workflow runs",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(newListWorkflowRunsCommand())
+	cmd.AddCommand(newGetWorkflowRunCommand())
+	cmd.AddCommand(newCancelWorkflowRunCommand())
+	cmd.AddCommand(newRerunWorkflowRunCommand())
+	cmd.AddCommand(newApproveWorkflowRunStepCommand())
+	cmd.AddCommand(newDenyWorkflowRunStepCommand())
+	cmd.AddCommand(newListPendingApprovalsCommand())
+
+	return cmd
+}
+
+func newListWorkflowRunsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "list [workflow name]",
+		Short:             "List the runs of a Relay workflow",
+		Args:              cobra.MaximumNArgs(1),
+		RunE:              doListWorkflowRuns,
+		ValidArgsFunction: doListWorkflowsCompletion,
+	}
+
+	return cmd
+}
+
+func doListWorkflowRuns(cmd *cobra.Command, args []string) error {
+	name, err := getWorkflowName(args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := Client.ListWorkflowRuns(name)
+	if err != nil {
+		debug.Logf("failed to list workflow runs: %s", err.Error())
+		return err
+	}
+
+	runs := resp.Runs
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].RunNumber > runs[j].RunNumber
+	})
+
+	t := Dialog.Table()
+
+	t.Headers([]string{"Run", "Status", "Started", "Ended", "Duration", "Revision", "Triggered By"})
+
+	for _, run := range runs {
+		state := run.State
+		if state == nil {
+			state = &model.WorkflowRunState{}
+		}
+
+		t.AppendRow([]string{
+			fmt.Sprintf("%d", run.RunNumber),
+			state.Status,
+			formatTime(state.StartedAt),
+			formatTime(state.EndedAt),
+			formatDuration(state.StartedAt, state.EndedAt),
+			revisionID(run.Revision),
+			run.CreatedBy.String(),
+		})
+	}
+
+	t.Flush()
+
+	return nil
+}
+
+func newGetWorkflowRunCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [workflow name] [run number]",
+		Short: "Show the parameters and step states of a Relay workflow run",
+		Args:  cobra.MaximumNArgs(2),
+		RunE:  doGetWorkflowRun,
+	}
+
+	return cmd
+}
+
+func doGetWorkflowRun(cmd *cobra.Command, args []string) error {
+	name, err := getWorkflowName(args)
+	if err != nil {
+		return err
+	}
+
+	runNumber, err := getRunNumber(args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := Client.GetWorkflowRun(name, runNumber)
+	if err != nil {
+		return err
+	}
+
+	if Config.Out == config.OutputTypeJSON {
+		resp.OutputJSON()
+		return nil
+	}
+
+	run := resp.Run
+	state := run.State
+	if state == nil {
+		state = &model.WorkflowRunState{}
+	}
+
+	Dialog.Infof(`Workflow:     %s
+Run:          %d
+Status:       %s
+Revision:     %s
+Triggered by: %s
+Started:      %s
+Ended:        %s
+Duration:     %s
+Link:         %s
+`,
+		name,
+		run.RunNumber,
+		state.Status,
+		revisionID(run.Revision),
+		run.CreatedBy.String(),
+		formatTime(state.StartedAt),
+		formatTime(state.EndedAt),
+		formatDuration(state.StartedAt, state.EndedAt),
+		format.GuiLink(Config, "/workflows/%s/runs/%d/graph", name, run.RunNumber),
+	)
+
+	if len(run.Parameters) > 0 {
+		t := Dialog.Table()
+
+		t.Headers([]string{"Parameter", "Value"})
+
+		for _, param := range sortedParameterNames(run.Parameters) {
+			t.AppendRow([]string{param, formatParameterValue(run.Parameters[param])})
+		}
+
+		t.Flush()
+	}
+
+	outputRunSteps(state)
+
+	return nil
+}
+
+func newCancelWorkflowRunCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [workflow name] [run number]",
+		Short: "Cancel a Relay workflow run",
+		Args:  cobra.MaximumNArgs(2),
+		RunE:  doCancelWorkflowRun,
+	}
+
+	return cmd
+}
+
+func doCancelWorkflowRun(cmd *cobra.Command, args []string) error {
+	name, err := getWorkflowName(args)
+	if err != nil {
+		return err
+	}
+
+	runNumber, err := getRunNumber(args)
+	if err != nil {
+		return err
+	}
+
+	proceed, err := util.Confirm("Are you sure you want to cancel this run?", Config)
+	if err != nil {
+		return err
+	}
+
+	if !proceed {
+		return nil
+	}
+
+	Dialog.Progress("Cancelling run...")
+
+	if _, err := Client.CancelWorkflowRun(name, runNumber); err != nil {
+		return err
+	}
+
+	Dialog.Infof("Run %d of workflow %s successfully cancelled", runNumber, name)
+
+	return nil
+}
+
+func newRerunWorkflowRunCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rerun [workflow name] [run number]",
+		Short: "Start a new run using the parameters of a previous run",
+		Long: `Start a new run using the parameters of a previous run.
+
+New runs always use the latest revision of the workflow. If the previous run
+used an older revision, roll the workflow back to that revision first to rerun
+it as it was.`,
+		Args: cobra.MaximumNArgs(2),
+		RunE: doRerunWorkflowRun,
+	}
+
+	cmd.Flags().StringArrayP("parameter", "p", []string{}, "Override a parameter of the previous run")
+	cmd.Flags().BoolP("watch", "w", false, "Wait for the run to finish, exiting non-zero if it does not succeed")
+	cmd.Flags().Duration("timeout", 0, "Maximum time to wait for the run to finish when watching (default no limit)")
+
+	return cmd
+}
+
+func doRerunWorkflowRun(cmd *cobra.Command, args []string) error {
+	overrides, ferr := cmd.Flags().GetStringArray("parameter")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	watch, ferr := cmd.Flags().GetBool("watch")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	name, err := getWorkflowName(args)
+	if err != nil {
+		return err
+	}
+
+	runNumber, err := getRunNumber(args)
+	if err != nil {
+		return err
+	}
+
+	Dialog.Progress("Fetching previous run...")
+
+	prev, err := Client.GetWorkflowRun(name, runNumber)
+	if err != nil {
+		return err
+	}
+
+	wf, err := Client.GetWorkflow(name)
+	if err != nil {
+		return err
+	}
+
+	if wf.Workflow.LatestRevision != nil {
+		original, latest := revisionID(prev.Run.Revision), revisionID(*wf.Workflow.LatestRevision)
+
+		if original != latest {
+			Dialog.Warnf("Run %d used revision %s, but the new run will use the latest revision %s", runNumber, original, latest)
+		}
+	}
+
+	params := make(map[string]interface{}, len(prev.Run.Parameters))
+	for key, param := range prev.Run.Parameters {
+		if param != nil {
+			params[key] = param.Value
+		}
+	}
+
+	values, err := parseParameterValues(overrides)
+	if err != nil {
+		return err
+	}
+
+	for key, val := range values {
+		params[key] = val
+	}
+
+	Dialog.Progress("Starting your workflow...")
+
+	resp, err := Client.RunWorkflow(name, params)
+	if err != nil {
+		return err
+	}
+
+	link := format.GuiLink(Config, "/workflows/%s/runs/%d/graph", name, resp.Run.RunNumber)
+	Dialog.Infof("Run %d has started from the parameters of run %d. Monitor its progress here: %s", resp.Run.RunNumber, runNumber, link)
+
+	if watch {
+		return watchWorkflowRun(cmd, name, resp.Run.RunNumber)
+	}
+
+	return nil
+}
+
+// getRunNumber gets the run number from the second argument. If none is
+// supplied, reads it from stdin.
+func getRunNumber(args []string) (int, errors.Error) {
+	var input string
+
+	if len(args) > 1 {
+		input = args[1]
+	} else {
+		reader := bufio.NewReader(os.Stdin)
+
+		fmt.Print("Run number: ")
+		prompt, err := reader.ReadString('\n')
+		if err != nil {
+			return 0, errors.NewWorkflowRunNumberReadError().WithCause(err)
+		}
+
+		input = prompt
+	}
+
+	input = strings.TrimSpace(input)
+
+	runNumber, err := strconv.Atoi(input)
+	if err != nil || runNumber < 1 {
+		return 0, errors.NewWorkflowInvalidRunNumberError(input)
+	}
+
+	return runNumber, nil
+}
+
+func revisionID(rev model.RevisionSummary) string {
+	if rev.RevisionIdentifier == nil {
+		return ""
+	}
+
+	return rev.ID
+}
+
+func sortedParameterNames(params map[string]*model.WorkflowRunParameter) []string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func formatParameterValue(param *model.WorkflowRunParameter) string {
+	if param == nil {
+		return ""
+	}
+
+	return formatValue(param.Value)
+}
+
+// formatValue renders an arbitrary parameter value for display, leaving
+// strings as-is and encoding everything else as JSON.
+func formatValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/schedule"
+	"github.com/spf13/cobra"
+)
+
+func newScheduleWorkflowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [workflow name]",
+		Short: "Preview when the schedule triggers of a Relay workflow will run",
+		Long: `Preview when the schedule triggers of a Relay workflow will run.
+
+Lists the next fire times of each schedule trigger of a local workflow file when
+--file is given, or otherwise of the latest revision of a workflow. Schedules are
+evaluated locally in UTC, as Relay does, and shown in --timezone. Schedules that
+fire at least as often as --warn-interval are reported, and the command exits
+non-zero if any schedule is not a valid cron expression.`,
+		Args:              cobra.MaximumNArgs(1),
+		RunE:              doScheduleWorkflow,
+		ValidArgsFunction: doListWorkflowsCompletion,
+	}
+
+	cmd.Flags().StringP("file", "f", "", "Path to a local Relay workflow file")
+	cmd.Flags().IntP("count", "n", 5, "Number of fire times to list for each trigger")
+	cmd.Flags().String("timezone", "UTC", "Timezone to show fire times in, such as Local or America/New_York")
+	cmd.Flags().Duration("warn-interval", time.Hour, "Warn about schedules that fire at least this often (0 to disable)")
+
+	return cmd
+}
+
+func doScheduleWorkflow(cmd *cobra.Command, args []string) error {
+	count, ferr := cmd.Flags().GetInt("count")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	if count < 1 {
+		return errors.NewWorkflowInvalidScheduleCountError(fmt.Sprintf("%d", count))
+	}
+
+	timezone, ferr := cmd.Flags().GetString("timezone")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	warnInterval, ferr := cmd.Flags().GetDuration("warn-interval")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	loc, lerr := time.LoadLocation(timezone)
+	if lerr != nil {
+		return errors.NewWorkflowInvalidTimezoneError(timezone).WithCause(lerr)
+	}
+
+	var triggers []*schedule.Trigger
+
+	if cmd.Flags().Changed("file") {
+		path, content, err := readFile(cmd)
+		if err != nil {
+			return err
+		}
+
+		var derr error
+		triggers, derr = schedule.FromFile([]byte(content))
+		if derr != nil {
+			return errors.NewWorkflowWorkflowFileDecodeError(path).WithCause(derr)
+		}
+	} else {
+		name, err := getWorkflowName(args)
+		if err != nil {
+			return err
+		}
+
+		rev, err := Client.GetLatestRevision(name)
+		if err != nil {
+			return err
+		}
+
+		triggers = schedule.FromRevision(rev.Revision)
+	}
+
+	if len(triggers) == 0 {
+		Dialog.Info("No schedule triggers found")
+		return nil
+	}
+
+	now := time.Now()
+
+	t := Dialog.Table()
+
+	t.Headers([]string{"Trigger", "Schedule", "Next Run"})
+
+	var invalid int
+	var frequent []*schedule.Preview
+
+	for _, trigger := range triggers {
+		preview, err := schedule.Next(trigger, now, count, loc)
+		if err != nil {
+			invalid++
+			t.AppendRow([]string{trigger.Name, trigger.Schedule, "invalid: " + err.Error()})
+			continue
+		}
+
+		if len(preview.Times) == 0 {
+			t.AppendRow([]string{trigger.Name, trigger.Schedule, "never"})
+			continue
+		}
+
+		for _, next := range preview.Times {
+			t.AppendRow([]string{trigger.Name, trigger.Schedule, next.Format(time.RFC3339)})
+		}
+
+		if warnInterval > 0 && preview.MinInterval > 0 && preview.MinInterval <= warnInterval {
+			frequent = append(frequent, preview)
+		}
+	}
+
+	t.Flush()
+
+	for _, preview := range frequent {
+		Dialog.Warnf("Trigger %s (%s) fires as often as every %s", preview.Trigger.Name, preview.Trigger.Schedule, preview.MinInterval)
+	}
+
+	if invalid > 0 {
+		return errors.NewWorkflowInvalidScheduleError(fmt.Sprintf("%d", invalid))
+	}
+
+	return nil
+}
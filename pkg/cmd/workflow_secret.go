@@ -5,7 +5,11 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
 	"strings"
 	"syscall"
 
@@ -28,6 +32,9 @@ func newSecretCommand() *cobra.Command {
 	cmd.AddCommand(newSetSecretCommand())
 	cmd.AddCommand(newListSecretsCommand())
 	cmd.AddCommand(newDeleteSecretCommand())
+	cmd.AddCommand(newImportSecretsCommand())
+	cmd.AddCommand(newCopySecretsCommand())
+	cmd.AddCommand(newCheckSecretsCommand())
 
 	return cmd
 }
@@ -41,6 +48,9 @@ func newSetSecretCommand() *cobra.Command {
 	}
 
 	cmd.Flags().Bool("value-stdin", false, "accept secret value from stdin")
+	cmd.Flags().String("from-file", "", "read secret value from a file, such as an SSH key")
+	cmd.Flags().String("from-env", "", "read secret value from an environment variable")
+	cmd.Flags().String("from-exec", "", "read secret value from the output of a shell command, such as a password manager")
 
 	return cmd
 }
@@ -231,8 +241,9 @@ func getSecretName(args []string) (string, errors.Error) {
 	return strings.TrimSpace(namePrompt), nil
 }
 
-// getSecretValue either prompts for the value of the secret with hidden input, or accepts the value from stdin if the
-// --value-stdin boolean flag is set
+// getSecretValue either prompts for the value of the secret with hidden input,
+// or reads it from the source selected by the --value-stdin, --from-file,
+// --from-env or --from-exec flags.
 func getSecretValue(cmd *cobra.Command) (string, errors.Error) {
 	var value string
 
@@ -241,29 +252,47 @@ func getSecretValue(cmd *cobra.Command) (string, errors.Error) {
 		return "", errors.NewGeneralUnknownError().WithCause(err)
 	}
 
-	if valueFromStdin {
-		gotStdin, err := util.PassedStdin()
-		if err != nil {
-			return "", errors.NewSecretFailedValueFromStdin().WithCause(err)
-		}
+	fromFile, err := cmd.Flags().GetString("from-file")
+	if err != nil {
+		return "", errors.NewGeneralUnknownError().WithCause(err)
+	}
 
-		if gotStdin {
-			buf := bytes.Buffer{}
-			reader := &io.LimitedReader{R: os.Stdin, N: readLimit}
+	fromEnv, err := cmd.Flags().GetString("from-env")
+	if err != nil {
+		return "", errors.NewGeneralUnknownError().WithCause(err)
+	}
 
-			n, err := buf.ReadFrom(reader)
-			if err != nil && err != io.EOF {
-				return "", errors.NewSecretFailedValueFromStdin().WithCause(err)
-			}
-			if n == 0 {
-				return "", errors.NewSecretFailedNoStdin()
-			}
+	fromExec, err := cmd.Flags().GetString("from-exec")
+	if err != nil {
+		return "", errors.NewGeneralUnknownError().WithCause(err)
+	}
 
-			value = buf.String()
-		} else {
-			return "", errors.NewSecretFailedNoStdin()
+	sources := 0
+	for _, set := range []bool{valueFromStdin, fromFile != "", fromEnv != "", fromExec != ""} {
+		if set {
+			sources++
 		}
-	} else {
+	}
+
+	if sources > 1 {
+		return "", errors.NewSecretConflictingValueSourcesError()
+	}
+
+	switch {
+	case fromFile != "":
+		return readSecretValueFile(fromFile)
+	case fromEnv != "":
+		value, ok := os.LookupEnv(fromEnv)
+		if !ok {
+			return "", errors.NewSecretValueEnvNotSetError(fromEnv)
+		}
+
+		return value, nil
+	case fromExec != "":
+		return readSecretValueExec(fromExec)
+	case valueFromStdin:
+		return readSecretValueStdin()
+	default:
 		fmt.Print("Value: ")
 		valueBytes, err := terminal.ReadPassword(int(syscall.Stdin))
 		if err != nil {
@@ -278,21 +307,90 @@ func getSecretValue(cmd *cobra.Command) (string, errors.Error) {
 	return value, nil
 }
 
+// readSecretValueStdin reads a secret value piped to stdin.
+func readSecretValueStdin() (string, errors.Error) {
+	gotStdin, err := util.PassedStdin()
+	if err != nil {
+		return "", errors.NewSecretFailedValueFromStdin().WithCause(err)
+	}
+
+	if !gotStdin {
+		return "", errors.NewSecretFailedNoStdin()
+	}
+
+	buf := bytes.Buffer{}
+	reader := &io.LimitedReader{R: os.Stdin, N: readLimit}
+
+	n, err := buf.ReadFrom(reader)
+	if err != nil && err != io.EOF {
+		return "", errors.NewSecretFailedValueFromStdin().WithCause(err)
+	}
+	if n == 0 {
+		return "", errors.NewSecretFailedNoStdin()
+	}
+
+	return buf.String(), nil
+}
+
+// readSecretValueFile reads a secret value from a file as is, so that
+// multi-line values such as SSH keys keep their trailing newline.
+func readSecretValueFile(path string) (string, errors.Error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", errors.NewSecretValueFileReadError(path).WithCause(err)
+	}
+	defer f.Close()
+
+	b, err := ioutil.ReadAll(&io.LimitedReader{R: f, N: readLimit + 1})
+	if err != nil {
+		return "", errors.NewSecretValueFileReadError(path).WithCause(err)
+	}
+
+	if len(b) > readLimit {
+		return "", errors.NewSecretValueTooLargeError(strconv.Itoa(readLimit))
+	}
+
+	return string(b), nil
+}
+
+// readSecretValueExec runs a command with the user's shell and uses its
+// output as the secret value. The trailing newline most commands print is
+// removed. The command can still prompt on the terminal, for example to unlock
+// a password manager.
+func readSecretValueExec(command string) (string, errors.Error) {
+	var c *exec.Cmd
+	if runtime.GOOS == "windows" {
+		c = exec.Command("cmd", "/C", command)
+	} else {
+		c = exec.Command("sh", "-c", command)
+	}
+
+	c.Stdin = os.Stdin
+	c.Stderr = os.Stderr
+
+	out, err := c.Output()
+	if err != nil {
+		return "", errors.NewSecretValueExecError(command).WithCause(err)
+	}
+
+	if len(out) > readLimit {
+		return "", errors.NewSecretValueTooLargeError(strconv.Itoa(readLimit))
+	}
+
+	value := strings.TrimSuffix(string(out), "\n")
+	value = strings.TrimSuffix(value, "\r")
+
+	return value, nil
+}
+
 func secretUsed(rev *model.RevisionEntity, name string) bool {
 	if rev == nil || rev.Revision == nil {
 		return false
 	}
 
-	for _, step := range rev.Revision.Steps {
-		if step.References == nil {
-			// Possibly non-container step type.
-			continue
-		}
-
-		for _, secret := range step.References.Secrets {
-			if secret.Name == name {
-				return true
-			}
+	for _, secret := range rev.Revision.ReferencedSecrets() {
+		if secret == name {
+			return true
 		}
 	}
 
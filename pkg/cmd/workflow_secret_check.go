@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/puppetlabs/relay/pkg/debug"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretProblemMissing = "missing"
+	secretProblemUnused  = "unused"
+)
+
+type secretProblem struct {
+	Workflow string
+	Secret   string
+	Problem  string
+}
+
+func newCheckSecretsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check [workflow name]",
+		Short: "Check that the secrets used by a Relay workflow are set",
+		Long: `Check that the secrets used by a Relay workflow are set.
+
+Secrets that are used by the steps of the latest revision of a workflow but are
+not set are reported as missing; the workflow would fail when it runs. Secrets
+that are set but not used by any step are reported as unused. The command exits
+non-zero if any secrets are missing.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: doCheckSecrets,
+	}
+
+	cmd.Flags().Bool("all", false, "Check every workflow")
+
+	return cmd
+}
+
+func doCheckSecrets(cmd *cobra.Command, args []string) error {
+	all, ferr := cmd.Flags().GetBool("all")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	var names []string
+
+	if all {
+		Dialog.Progress("Fetching workflows...")
+
+		req := Client.Api.ViewsApi.GetWorkflowsView(cmd.Context())
+		wv, _, verr := Client.Api.ViewsApi.GetWorkflowsViewExecute(req)
+		if verr != nil {
+			debug.Logf("failed to list workflows: %s", verr.Error())
+			return verr
+		}
+
+		for _, workflow := range wv.Workflows {
+			names = append(names, workflow.Name)
+		}
+
+		sort.Strings(names)
+	} else {
+		name, err := getWorkflowName(args)
+		if err != nil {
+			return err
+		}
+
+		names = []string{name}
+	}
+
+	var problems []*secretProblem
+
+	for _, name := range names {
+		Dialog.Progress("Checking secrets for workflow " + name)
+
+		resp, err := Client.ListWorkflowSecrets(name)
+		if err != nil {
+			debug.Logf("failed to list workflow secrets: %s", err.Error())
+			return err
+		}
+
+		set := make([]string, 0, len(resp.WorkflowSecrets))
+		for _, secret := range resp.WorkflowSecrets {
+			set = append(set, secret.Name)
+		}
+
+		var referenced []string
+
+		rev, err := Client.GetLatestRevision(name)
+		if err != nil && !errors.IsClientResponseNotFound(err) {
+			return err
+		} else if err == nil && rev.Revision != nil {
+			referenced = rev.Revision.ReferencedSecrets()
+		}
+
+		problems = append(problems, auditWorkflowSecrets(name, referenced, set)...)
+	}
+
+	if len(problems) == 0 {
+		Dialog.Infof("All secrets are set and used in %d workflows", len(names))
+		return nil
+	}
+
+	t := Dialog.Table()
+
+	t.Headers([]string{"Workflow", "Secret", "Problem"})
+
+	missing := 0
+
+	for _, problem := range problems {
+		if problem.Problem == secretProblemMissing {
+			missing++
+		}
+
+		t.AppendRow([]string{problem.Workflow, problem.Secret, problem.Problem})
+	}
+
+	t.Flush()
+
+	if missing > 0 {
+		return errors.NewSecretCheckFailedError(fmt.Sprintf("%d", missing))
+	}
+
+	return nil
+}
+
+// auditWorkflowSecrets compares the secrets referenced by a workflow with the
+// secrets set on it. Missing secrets are listed before unused ones, and each
+// group is sorted by name.
+func auditWorkflowSecrets(workflow string, referenced, set []string) []*secretProblem {
+	isSet := make(map[string]bool, len(set))
+	for _, name := range set {
+		isSet[name] = true
+	}
+
+	isReferenced := make(map[string]bool, len(referenced))
+	for _, name := range referenced {
+		isReferenced[name] = true
+	}
+
+	var missing, unused []string
+
+	for name := range isReferenced {
+		if !isSet[name] {
+			missing = append(missing, name)
+		}
+	}
+
+	for name := range isSet {
+		if !isReferenced[name] {
+			unused = append(unused, name)
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(unused)
+
+	var problems []*secretProblem
+
+	for _, name := range missing {
+		problems = append(problems, &secretProblem{Workflow: workflow, Secret: name, Problem: secretProblemMissing})
+	}
+
+	for _, name := range unused {
+		problems = append(problems, &secretProblem{Workflow: workflow, Secret: name, Problem: secretProblemUnused})
+	}
+
+	return problems
+}
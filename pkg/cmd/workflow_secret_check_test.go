@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditWorkflowSecrets(t *testing.T) {
+	problems := auditWorkflowSecrets("wf", []string{"token", "password", "token"}, []string{"unused", "token"})
+
+	require.Equal(t, []*secretProblem{
+		{Workflow: "wf", Secret: "password", Problem: secretProblemMissing},
+		{Workflow: "wf", Secret: "unused", Problem: secretProblemUnused},
+	}, problems)
+
+	require.Empty(t, auditWorkflowSecrets("wf", []string{"a"}, []string{"a"}))
+}
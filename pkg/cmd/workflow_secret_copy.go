@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"syscall"
+
+	"github.com/puppetlabs/relay/pkg/client"
+	"github.com/puppetlabs/relay/pkg/debug"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/model"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+func newCopySecretsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "copy [secret name...]",
+		Short: "Copy Relay workflow secrets to other workflows or contexts",
+		Long: `Copy Relay workflow secrets to other workflows or contexts.
+
+The secrets set on the --from workflow, or only the named secrets, are set on
+each --to workflow. Secret values cannot be read back from Relay, so each value
+is read from --values-file or prompted for once and then set on every target.
+
+Use --from-context and --to-context to copy between contexts, for example from
+staging to production. If --to is not given, secrets are copied to the workflow
+with the same name in the target context.`,
+		RunE: doCopySecrets,
+	}
+
+	cmd.Flags().String("from", "", "Workflow to copy secrets from")
+	cmd.Flags().StringSlice("to", nil, "Workflow to copy secrets to (can be repeated)")
+	cmd.Flags().String("from-context", "", "Context of the --from workflow (default current context)")
+	cmd.Flags().String("to-context", "", "Context of the --to workflows (default current context)")
+	cmd.Flags().String("values-file", "", "Path to a .env, .json or .yaml file of secret values")
+
+	return cmd
+}
+
+func doCopySecrets(cmd *cobra.Command, args []string) error {
+	from, ferr := cmd.Flags().GetString("from")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	to, ferr := cmd.Flags().GetStringSlice("to")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	fromContext, ferr := cmd.Flags().GetString("from-context")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	toContext, ferr := cmd.Flags().GetString("to-context")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	valuesFile, ferr := cmd.Flags().GetString("values-file")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	if from == "" {
+		return errors.NewSecretMissingCopySourceError()
+	}
+
+	if fromContext == "" {
+		fromContext = Config.CurrentContext
+	}
+
+	if toContext == "" {
+		toContext = Config.CurrentContext
+	}
+
+	if len(to) == 0 {
+		to = []string{from}
+	}
+
+	if fromContext == toContext {
+		for _, target := range to {
+			if target == from {
+				return errors.NewSecretCopySameWorkflowError(from)
+			}
+		}
+	}
+
+	fromClient, err := getContextClient(fromContext)
+	if err != nil {
+		return err
+	}
+
+	toClient, err := getContextClient(toContext)
+	if err != nil {
+		return err
+	}
+
+	Dialog.Progress("Fetching secrets for workflow " + from)
+
+	resp, err := fromClient.ListWorkflowSecrets(from)
+	if err != nil {
+		debug.Logf("failed to list workflow secrets: %s", err.Error())
+		return err
+	}
+
+	names, err := selectSecretNames(from, resp.WorkflowSecrets, args)
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]string)
+	if valuesFile != "" {
+		if values, err = readSecretsFile(valuesFile); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := values[name]; ok {
+			continue
+		}
+
+		fmt.Printf("Value for %s: ", name)
+		valueBytes, err := terminal.ReadPassword(int(syscall.Stdin))
+		if err != nil {
+			return errors.NewSecretFailedValueFromStdin().WithCause(err)
+		}
+
+		// resets to new line after hidden input
+		fmt.Println("")
+
+		values[name] = string(valueBytes)
+	}
+
+	t := Dialog.Table()
+
+	t.Headers([]string{"Workflow", "Secret", "Action"})
+
+	for _, target := range to {
+		Dialog.Progress("Copying secrets to workflow " + target)
+
+		resp, err := toClient.ListWorkflowSecrets(target)
+		if err != nil {
+			debug.Logf("failed to list workflow secrets: %s", err.Error())
+			return err
+		}
+
+		existing := make(map[string]bool, len(resp.WorkflowSecrets))
+		for _, secret := range resp.WorkflowSecrets {
+			existing[secret.Name] = true
+		}
+
+		for _, name := range names {
+			action := secretImportActionCreate
+
+			if existing[name] {
+				action = secretImportActionUpdate
+
+				if _, err := toClient.UpdateWorkflowSecret(target, name, values[name]); err != nil {
+					return err
+				}
+			} else if _, err := toClient.CreateWorkflowSecret(target, name, values[name]); err != nil {
+				return err
+			}
+
+			t.AppendRow([]string{target, name, action})
+		}
+	}
+
+	t.Flush()
+
+	Dialog.Infof("Copied %d secrets from workflow %s to %d workflows", len(names), from, len(to))
+
+	return nil
+}
+
+// selectSecretNames returns the requested secret names, checking that each is
+// set on the source workflow, or all of the secrets set on it if none are
+// requested. The names are sorted.
+func selectSecretNames(workflow string, set []model.WorkflowSecretSummary, requested []string) ([]string, errors.Error) {
+	isSet := make(map[string]bool, len(set))
+	for _, secret := range set {
+		isSet[secret.Name] = true
+	}
+
+	var names []string
+
+	if len(requested) == 0 {
+		for name := range isSet {
+			names = append(names, name)
+		}
+	} else {
+		for _, name := range requested {
+			if !isSet[name] {
+				return nil, errors.NewSecretCopySecretNotFoundError(name, workflow)
+			}
+
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// getContextClient returns the client for the current context, or a new
+// client configured for another context.
+func getContextClient(context string) (*client.Client, errors.Error) {
+	if context == Config.CurrentContext {
+		return Client, nil
+	}
+
+	if _, ok := Config.ContextConfig[context]; !ok {
+		return nil, errors.NewConfigUnknownContext(context)
+	}
+
+	cfg := *Config
+	cfg.CurrentContext = context
+
+	return client.NewClient(&cfg), nil
+}
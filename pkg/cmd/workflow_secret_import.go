@@ -0,0 +1,352 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/puppetlabs/relay/pkg/debug"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/puppetlabs/relay/pkg/util"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	secretImportActionCreate = "create"
+	secretImportActionUpdate = "update"
+	secretImportActionDelete = "delete"
+)
+
+// dotenvCommentPattern matches the start of a comment after an unquoted value.
+var dotenvCommentPattern = regexp.MustCompile(`\s#`)
+
+type secretImportAction struct {
+	Name   string
+	Action string
+	Value  string
+}
+
+func newImportSecretsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [workflow name]",
+		Short: "Set many Relay workflow secrets from a file",
+		Long: `Set many Relay workflow secrets from a file.
+
+The file may be a dotenv file (KEY=value lines), or a JSON or YAML object
+mapping secret names to values; the format is chosen by the file extension.
+Secrets that are already set are updated, as their current values cannot be
+read back. The planned changes are printed before they are made.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: doImportSecrets,
+	}
+
+	cmd.Flags().String("from", "", "Path to a .env, .json or .yaml file of secrets")
+	cmd.Flags().Bool("prune", false, "Delete secrets that are not in the file")
+	cmd.Flags().Bool("dry-run", false, "Print the planned changes without making them")
+
+	return cmd
+}
+
+func doImportSecrets(cmd *cobra.Command, args []string) error {
+	from, ferr := cmd.Flags().GetString("from")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	prune, ferr := cmd.Flags().GetBool("prune")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	dryRun, ferr := cmd.Flags().GetBool("dry-run")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	if from == "" {
+		return errors.NewSecretMissingImportFileError()
+	}
+
+	workflowName, err := getWorkflowName(args)
+	if err != nil {
+		return err
+	}
+
+	secrets, err := readSecretsFile(from)
+	if err != nil {
+		return err
+	}
+
+	Dialog.Progress("Fetching secrets...")
+
+	resp, err := Client.ListWorkflowSecrets(workflowName)
+	if err != nil {
+		debug.Logf("failed to list workflow secrets: %s", err.Error())
+		return err
+	}
+
+	existing := make([]string, 0, len(resp.WorkflowSecrets))
+	for _, secret := range resp.WorkflowSecrets {
+		existing = append(existing, secret.Name)
+	}
+
+	plan := planSecretImport(secrets, existing, prune)
+
+	t := Dialog.Table()
+
+	t.Headers([]string{"Secret", "Action"})
+
+	deletions := 0
+
+	for _, action := range plan {
+		if action.Action == secretImportActionDelete {
+			deletions++
+		}
+
+		t.AppendRow([]string{action.Name, action.Action})
+	}
+
+	t.Flush()
+
+	if dryRun {
+		Dialog.Infof("Dry run: %d secrets would be changed", len(plan))
+		return nil
+	}
+
+	if len(plan) == 0 {
+		Dialog.Info("No secrets to import")
+		return nil
+	}
+
+	if deletions > 0 {
+		proceed, err := util.Confirm(fmt.Sprintf("Are you sure you want to delete %d secrets?", deletions), Config)
+		if err != nil {
+			return err
+		}
+
+		if !proceed {
+			return nil
+		}
+	}
+
+	for _, action := range plan {
+		switch action.Action {
+		case secretImportActionCreate:
+			Dialog.Progress("Creating secret " + action.Name)
+
+			if _, err := Client.CreateWorkflowSecret(workflowName, action.Name, action.Value); err != nil {
+				return err
+			}
+		case secretImportActionUpdate:
+			Dialog.Progress("Updating secret " + action.Name)
+
+			if _, err := Client.UpdateWorkflowSecret(workflowName, action.Name, action.Value); err != nil {
+				return err
+			}
+		case secretImportActionDelete:
+			Dialog.Progress("Deleting secret " + action.Name)
+
+			if _, err := Client.DeleteWorkflowSecret(workflowName, action.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	Dialog.Infof("Imported %d secret changes from %s into workflow %s", len(plan), from, workflowName)
+
+	return nil
+}
+
+// readSecretsFile reads secret names and values from a dotenv, JSON or YAML
+// file, depending on its extension.
+func readSecretsFile(path string) (map[string]string, errors.Error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.NewSecretImportFileReadError(path).WithCause(err)
+	}
+
+	var secrets map[string]string
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json", ".yaml", ".yml":
+		secrets, err = parseSecretsMapping(b)
+	default:
+		secrets, err = parseSecretsDotenv(b)
+	}
+
+	if err != nil {
+		return nil, errors.NewSecretImportFileDecodeError(path).WithCause(err)
+	}
+
+	return secrets, nil
+}
+
+// parseSecretsDotenv parses KEY=value lines. Blank lines, comments and a
+// leading "export" are ignored, and values may be single or double quoted.
+func parseSecretsDotenv(content []byte) (map[string]string, error) {
+	secrets := make(map[string]string)
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+
+		i := strings.Index(line, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("line %d: expected KEY=value", n)
+		}
+
+		name := strings.TrimSpace(line[:i])
+
+		value, err := dotenvValue(strings.TrimSpace(line[i+1:]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+
+		secrets[name] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return secrets, nil
+}
+
+// dotenvValue reads the value of a dotenv line. Single quoted values are kept
+// as written. Double quoted values may use the escapes \n, \" and \\, and any
+// other backslash is kept as written. A comment may follow a quoted value, and
+// an unquoted value ends at a # that follows whitespace.
+func dotenvValue(value string) (string, error) {
+	var rest string
+
+	switch {
+	case strings.HasPrefix(value, `"`):
+		var b strings.Builder
+
+		closed := false
+
+		for i := 1; i < len(value) && !closed; i++ {
+			switch c := value[i]; {
+			case c == '\\' && i+1 < len(value) && value[i+1] == 'n':
+				b.WriteByte('\n')
+				i++
+			case c == '\\' && i+1 < len(value) && (value[i+1] == '"' || value[i+1] == '\\'):
+				b.WriteByte(value[i+1])
+				i++
+			case c == '"':
+				closed = true
+				rest = value[i+1:]
+			default:
+				b.WriteByte(c)
+			}
+		}
+
+		if !closed {
+			return "", fmt.Errorf("missing closing double quote")
+		}
+
+		value = b.String()
+	case strings.HasPrefix(value, "'"):
+		end := strings.Index(value[1:], "'")
+		if end < 0 {
+			return "", fmt.Errorf("missing closing single quote")
+		}
+
+		value, rest = value[1:end+1], value[end+2:]
+	default:
+		if loc := dotenvCommentPattern.FindStringIndex(value); loc != nil {
+			value = strings.TrimSpace(value[:loc[0]])
+		}
+
+		return value, nil
+	}
+
+	if rest = strings.TrimSpace(rest); rest != "" && !strings.HasPrefix(rest, "#") {
+		return "", fmt.Errorf("unexpected %q after quoted value", rest)
+	}
+
+	return value, nil
+}
+
+// parseSecretsMapping parses a JSON or YAML object of secret names to scalar
+// values. Values are kept as written, so 0123 stays 0123.
+func parseSecretsMapping(content []byte) (map[string]string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+
+	secrets := make(map[string]string)
+
+	if len(doc.Content) == 0 {
+		return secrets, nil
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("line %d: expected an object of secret names to values", root.Line)
+	}
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+
+		if value.Kind != yaml.ScalarNode {
+			return nil, fmt.Errorf("line %d: secret %q must have a string value", value.Line, key.Value)
+		}
+
+		if value.Tag == "!!null" {
+			return nil, fmt.Errorf("line %d: secret %q has no value", value.Line, key.Value)
+		}
+
+		secrets[key.Value] = value.Value
+	}
+
+	return secrets, nil
+}
+
+// planSecretImport decides what to do with each secret given the secrets in
+// the file and the names of the secrets already set. The plan is sorted by
+// secret name.
+func planSecretImport(secrets map[string]string, existing []string, prune bool) []*secretImportAction {
+	set := make(map[string]bool, len(existing))
+	for _, name := range existing {
+		set[name] = true
+	}
+
+	var plan []*secretImportAction
+
+	for name, value := range secrets {
+		action := &secretImportAction{Name: name, Action: secretImportActionCreate, Value: value}
+		if set[name] {
+			action.Action = secretImportActionUpdate
+		}
+
+		plan = append(plan, action)
+	}
+
+	if prune {
+		for name := range set {
+			if _, ok := secrets[name]; !ok {
+				plan = append(plan, &secretImportAction{Name: name, Action: secretImportActionDelete})
+			}
+		}
+	}
+
+	sort.Slice(plan, func(i, j int) bool {
+		return plan[i].Name < plan[j].Name
+	})
+
+	return plan
+}
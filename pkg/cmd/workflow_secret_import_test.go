@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSecretsDotenv(t *testing.T) {
+	secrets, err := parseSecretsDotenv([]byte(`
+# comment
+export TOKEN=abc
+PASSWORD="p=ss\nword"
+SINGLE='a "b"'
+EMPTY=
+WINDOWS="C:\path\to\dir"
+ESCAPES="a\d \"q\" \\n"
+COMMENTED=value # a note
+QUOTED_COMMENT="value # kept" # a note
+HASH=a#b
+`))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"TOKEN":          "abc",
+		"PASSWORD":       "p=ss\nword",
+		"SINGLE":         `a "b"`,
+		"EMPTY":          "",
+		"WINDOWS":        `C:\path\to\dir`,
+		"ESCAPES":        `a\d "q" \n`,
+		"COMMENTED":      "value",
+		"QUOTED_COMMENT": "value # kept",
+		"HASH":           "a#b",
+	}, secrets)
+
+	_, err = parseSecretsDotenv([]byte("NOVALUE\n"))
+	require.Error(t, err)
+
+	_, err = parseSecretsDotenv([]byte(`UNTERMINATED="abc` + "\n"))
+	require.Error(t, err)
+}
+
+func TestParseSecretsMapping(t *testing.T) {
+	secrets, err := parseSecretsMapping([]byte(`{"token": "abc", "pin": 0123}`))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"token": "abc", "pin": "0123"}, secrets)
+
+	_, err = parseSecretsMapping([]byte("token:\n  nested: value\n"))
+	require.Error(t, err)
+
+	for _, content := range []string{"token: ~\n", "token: null\n", "token:\n", `{"token": null}`} {
+		_, err = parseSecretsMapping([]byte(content))
+		require.EqualError(t, err, `line 1: secret "token" has no value`, content)
+	}
+
+	secrets, err = parseSecretsMapping([]byte(`token: ""`))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"token": ""}, secrets)
+}
+
+func TestPlanSecretImport(t *testing.T) {
+	plan := planSecretImport(map[string]string{"a": "1", "b": "2"}, []string{"b", "c"}, true)
+
+	require.Equal(t, []*secretImportAction{
+		{Name: "a", Action: secretImportActionCreate, Value: "1"},
+		{Name: "b", Action: secretImportActionUpdate, Value: "2"},
+		{Name: "c", Action: secretImportActionDelete},
+	}, plan)
+
+	require.Len(t, planSecretImport(map[string]string{}, []string{"c"}, false), 0)
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newWorkflowTriggersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "triggers [workflow name]",
+		Short: "Show the triggers of a Relay workflow",
+		Long: `Show the triggers of a Relay workflow.
+
+Each trigger is listed with its type and status, and the webhook URL, push token
+or next scheduled time that applies to it. Push tokens are masked unless
+--reveal is given.`,
+		Args:              cobra.MaximumNArgs(1),
+		RunE:              doWorkflowTriggers,
+		ValidArgsFunction: doListWorkflowsCompletion,
+	}
+
+	cmd.Flags().Bool("reveal", false, "Show push tokens in full")
+
+	return cmd
+}
+
+func doWorkflowTriggers(cmd *cobra.Command, args []string) error {
+	reveal, ferr := cmd.Flags().GetBool("reveal")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	name, err := getWorkflowName(args)
+	if err != nil {
+		return err
+	}
+
+	Dialog.Progress("Fetching workflow triggers...")
+
+	wf, err := Client.GetWorkflow(name)
+	if err != nil {
+		return err
+	}
+
+	if wf.Workflow.State == nil || len(wf.Workflow.State.Triggers) == 0 {
+		Dialog.Infof("Workflow %s has no triggers", name)
+		return nil
+	}
+
+	t := Dialog.Table()
+
+	t.Headers([]string{"Name", "Type", "Status", "Webhook URL", "Push Token", "Next Run"})
+
+	for _, trigger := range wf.Workflow.State.Triggers {
+		var typ, status, endpoint, token, next string
+
+		if source := trigger.Source; source != nil {
+			typ, status = source.Type, source.Status
+
+			if source.Webhook != nil {
+				endpoint = source.Webhook.Endpoint
+			}
+
+			if source.Push != nil {
+				token = source.Push.Token
+				if !reveal {
+					token = maskToken(token)
+				}
+			}
+
+			if source.Schedule != nil {
+				next = source.Schedule.ScheduledAt
+			}
+		}
+
+		t.AppendRow([]string{trigger.Name, typ, status, endpoint, token, next})
+	}
+
+	t.Flush()
+
+	return nil
+}
+
+// maskToken hides all but the last four characters of a token, or all of a
+// token too short to partly reveal.
+func maskToken(token string) string {
+	const visible = 4
+
+	if len(token) <= visible*2 {
+		return strings.Repeat("*", len(token))
+	}
+
+	return strings.Repeat("*", len(token)-visible) + token[len(token)-visible:]
+}
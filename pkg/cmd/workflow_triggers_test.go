@@ -0,0 +1,13 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaskToken(t *testing.T) {
+	require.Equal(t, "", maskToken(""))
+	require.Equal(t, "********", maskToken("abcdefgh"))
+	require.Equal(t, "*****fghi", maskToken("abcdefghi"))
+}
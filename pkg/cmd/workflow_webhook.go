@@ -0,0 +1,233 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/puppetlabs/relay/pkg/dev"
+	"github.com/puppetlabs/relay/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newWorkflowWebhookCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook",
+		Short: "Work with the webhook triggers of your Relay workflows",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(newReplayWebhookCommand())
+
+	return cmd
+}
+
+func newReplayWebhookCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay [workflow name]",
+		Short: "Send a captured webhook request to a webhook trigger",
+		Long: `Send a captured webhook request to a webhook trigger.
+
+The payload file is sent as the body of a POST request to the endpoint of the
+webhook trigger, with the headers from --headers, such as the event type header
+of a GitHub or PagerDuty webhook. Headers are a JSON object of header names to a
+value or a list of values.
+
+With --dev, the request is sent to the webhook trigger of the workflow on the
+dev cluster instead of Relay, through a port forwarded to the ingress gateway of
+the cluster. Webhook triggers are added to the dev cluster when the workflow is
+run with relay dev workflow run.`,
+		Args:              cobra.MaximumNArgs(1),
+		RunE:              doReplayWebhook,
+		ValidArgsFunction: doListWorkflowsCompletion,
+	}
+
+	cmd.Flags().String("trigger", "", "Name of the webhook trigger (required if the workflow has more than one)")
+	cmd.Flags().String("payload", "", "Path to the request body to send, or - to read stdin")
+	cmd.Flags().String("headers", "", "Path to a JSON file of request headers to send")
+	cmd.Flags().Bool("dev", false, "Send the request to the dev cluster")
+
+	return cmd
+}
+
+func doReplayWebhook(cmd *cobra.Command, args []string) error {
+	triggerName, ferr := cmd.Flags().GetString("trigger")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	payloadPath, ferr := cmd.Flags().GetString("payload")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	headersPath, ferr := cmd.Flags().GetString("headers")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	devMode, ferr := cmd.Flags().GetBool("dev")
+	if ferr != nil {
+		return errors.NewGeneralUnknownError().WithCause(ferr).Bug()
+	}
+
+	name, err := getWorkflowName(args)
+	if err != nil {
+		return err
+	}
+
+	var payload []byte
+	var rerr error
+
+	switch payloadPath {
+	case "":
+	case "-":
+		payload, rerr = ioutil.ReadAll(os.Stdin)
+	default:
+		payload, rerr = ioutil.ReadFile(payloadPath)
+	}
+
+	if rerr != nil {
+		return errors.NewWorkflowWebhookPayloadReadError(payloadPath).WithCause(rerr)
+	}
+
+	headers := http.Header{}
+
+	if headersPath != "" {
+		b, rerr := ioutil.ReadFile(headersPath)
+		if rerr != nil {
+			return errors.NewWorkflowWebhookHeadersReadError(headersPath).WithCause(rerr)
+		}
+
+		if headers, rerr = parseWebhookHeaders(b); rerr != nil {
+			return errors.NewWorkflowWebhookHeadersReadError(headersPath).WithCause(rerr)
+		}
+	}
+
+	if headers.Get("Content-Type") == "" && json.Valid(payload) {
+		headers.Set("Content-Type", "application/json")
+	}
+
+	var endpoint, host string
+
+	if devMode {
+		dm, derr := dev.NewManager(cmd.Context())
+		if derr != nil {
+			return derr
+		}
+
+		Dialog.Progress("Connecting to the webhook trigger on the dev cluster...")
+
+		ep, derr := dm.ForwardWebhookTrigger(cmd.Context(), name, triggerName)
+		if derr != nil {
+			return errors.NewWorkflowWebhookDevTriggerError().WithCause(derr)
+		}
+		defer ep.Close()
+
+		endpoint, host = ep.URL, ep.Host
+	} else {
+		Dialog.Progress("Fetching workflow triggers...")
+
+		rev, err := Client.GetLatestRevision(name)
+		if err != nil {
+			return err
+		}
+
+		trigger, err := selectTrigger(name, rev.Revision.Triggers, "webhook", triggerName)
+		if err != nil {
+			return err
+		}
+
+		wf, err := Client.GetWorkflow(name)
+		if err != nil {
+			return err
+		}
+
+		state := triggerState(wf.Workflow, trigger.Name)
+		if state == nil || state.Webhook == nil || state.Webhook.Endpoint == "" {
+			return errors.NewWorkflowWebhookEndpointUnavailableError(trigger.Name)
+		}
+
+		endpoint = state.Webhook.Endpoint
+	}
+
+	Dialog.Progress("Sending webhook request to " + endpoint)
+
+	req, rerr := http.NewRequestWithContext(cmd.Context(), http.MethodPost, endpoint, bytes.NewReader(payload))
+	if rerr != nil {
+		return errors.NewClientRequestError().WithCause(rerr)
+	}
+
+	for name, values := range headers {
+		if dev.IsWebhookConnectionHeader(name) {
+			continue
+		}
+
+		req.Header[name] = values
+	}
+
+	if host != "" {
+		req.Host = host
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	resp, rerr := client.Do(req)
+	if rerr != nil {
+		return errors.NewClientRequestError().WithCause(rerr)
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	if len(body) > 0 {
+		Dialog.WriteString(string(body))
+
+		if body[len(body)-1] != '\n' {
+			Dialog.WriteString("\n")
+		}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.NewWorkflowWebhookReplayFailedError(resp.Status)
+	}
+
+	Dialog.Infof("Webhook request to %s was accepted with status %s", endpoint, resp.Status)
+
+	return nil
+}
+
+// parseWebhookHeaders parses a JSON object of header names to a value or list
+// of values.
+func parseWebhookHeaders(content []byte) (http.Header, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(content, &raw); err != nil {
+		return nil, err
+	}
+
+	headers := http.Header{}
+
+	for name, value := range raw {
+		switch v := value.(type) {
+		case string:
+			headers.Add(name, v)
+		case []interface{}:
+			for _, item := range v {
+				s, ok := item.(string)
+				if !ok {
+					return nil, fmt.Errorf("header %s must be a string or a list of strings", name)
+				}
+
+				headers.Add(name, s)
+			}
+		default:
+			return nil, fmt.Errorf("header %s must be a string or a list of strings", name)
+		}
+	}
+
+	return headers, nil
+}
@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWebhookHeaders(t *testing.T) {
+	headers, err := parseWebhookHeaders([]byte(`{"X-GitHub-Event": "push", "accept": ["application/json", "text/plain"]}`))
+	require.NoError(t, err)
+	require.Equal(t, http.Header{
+		"X-Github-Event": {"push"},
+		"Accept":         {"application/json", "text/plain"},
+	}, headers)
+
+	_, err = parseWebhookHeaders([]byte(`{"X-Count": 1}`))
+	require.Error(t, err)
+}
@@ -7,8 +7,10 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 
+	"github.com/puppetlabs/relay/pkg/debug"
 	"github.com/puppetlabs/relay/pkg/errors"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -115,10 +117,56 @@ type LogServiceConfig struct {
 	Table                 string
 }
 
+// DefaultAuthCredential is the name of the credential used when none has been
+// chosen. Its tokens are stored directly in the auth section of a context.
+const DefaultAuthCredential = "default"
+
+var authCredentialNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// IsValidAuthCredentialName returns true if name can be used as the name of a
+// credential in the configuration file.
+func IsValidAuthCredentialName(name string) bool {
+	return authCredentialNamePattern.MatchString(name)
+}
+
 type AuthConfig struct {
+	// Credential is the name of the credential in use. If it is empty, the
+	// default credential is used.
+	Credential string
+
+	// Credentials are the tokens of every credential stored for the context,
+	// by credential name.
+	Credentials map[string]map[AuthTokenType]string
+
+	// Tokens are the tokens of the credential in use.
 	Tokens map[AuthTokenType]string
 }
 
+// CredentialName returns the name of the credential in use.
+func (ac *AuthConfig) CredentialName() string {
+	if ac == nil || ac.Credential == "" {
+		return DefaultAuthCredential
+	}
+
+	return ac.Credential
+}
+
+// Token returns the token used to authenticate and its type, following the
+// precedence of AuthTokenTypes.
+func (ac *AuthConfig) Token() (AuthTokenType, string, bool) {
+	if ac == nil {
+		return "", "", false
+	}
+
+	for _, tokenType := range AuthTokenTypes() {
+		if value := ac.Tokens[tokenType]; value != "" {
+			return tokenType, value, true
+		}
+	}
+
+	return "", "", false
+}
+
 type ContextConfig struct {
 	Auth    *AuthConfig
 	Domains *APIContext
@@ -232,7 +280,10 @@ func FromFlags(flags *pflag.FlagSet) (*Config, error) {
 	}
 
 	config := &Config{
-		Debug:    v.GetBool("debug"),
+		// A command may define its own --debug flag in place of the global
+		// one, like workflow apply, which uses -d for --dir, so the flag
+		// variable is checked as well.
+		Debug:    v.GetBool("debug") || debug.Enabled,
 		Yes:      v.GetBool("yes"),
 		Out:      output,
 		CacheDir: v.GetString("cache_dir"),
@@ -275,14 +326,20 @@ func FromFlags(flags *pflag.FlagSet) (*Config, error) {
 
 			authSection := contextSection.Sub("auth")
 			if authSection != nil {
-				config.ContextConfig[context].Auth = &AuthConfig{
-					Tokens: make(map[AuthTokenType]string),
+				auth := &AuthConfig{
+					Credential: authSection.GetString("credential"),
+					Credentials: map[string]map[AuthTokenType]string{
+						DefaultAuthCredential: readAuthTokens(authSection, "tokens"),
+					},
 				}
 
-				for _, tokenType := range AuthTokenTypes() {
-					token := authSection.GetString(fmt.Sprintf("tokens.%s", tokenType))
-					config.ContextConfig[context].Auth.Tokens[tokenType] = token
+				for name := range authSection.GetStringMap("credentials") {
+					auth.Credentials[name] = readAuthTokens(authSection, fmt.Sprintf("credentials.%s.tokens", name))
 				}
+
+				auth.Tokens = auth.Credentials[auth.CredentialName()]
+
+				config.ContextConfig[context].Auth = auth
 			}
 		}
 	}
@@ -304,12 +361,26 @@ func WriteConfig(cfg *Config, flags *pflag.FlagSet) error {
 
 	if cfg.ContextConfig != nil {
 		for context, config := range cfg.ContextConfig {
-			if config.Auth != nil && config.Auth.Tokens != nil {
-				tokens := config.Auth.Tokens
-				for name, value := range tokens {
-					v.Set(fmt.Sprintf("contexts.%s.auth.tokens.%s", context, name), value)
+			if config.Auth == nil {
+				continue
+			}
+
+			// The tokens are written to the named credential, which then
+			// becomes the credential in use.
+			key := fmt.Sprintf("contexts.%s.auth", context)
+			tokensKey := key + ".tokens"
+
+			if name := config.Auth.Credential; name != "" {
+				v.Set(key+".credential", name)
+
+				if name != DefaultAuthCredential {
+					tokensKey = fmt.Sprintf("%s.credentials.%s.tokens", key, name)
 				}
 			}
+
+			for name, value := range config.Auth.Tokens {
+				v.Set(fmt.Sprintf("%s.%s", tokensKey, name), value)
+			}
 		}
 	}
 
@@ -408,6 +479,18 @@ func userDataDir() string {
 	return filepath.Join(os.Getenv("HOME"), ".local", "share", RelayEnvironment)
 }
 
+// readAuthTokens reads the tokens of a credential from the given key of an
+// auth section.
+func readAuthTokens(v *viper.Viper, key string) map[AuthTokenType]string {
+	tokens := make(map[AuthTokenType]string)
+
+	for _, tokenType := range AuthTokenTypes() {
+		tokens[tokenType] = v.GetString(fmt.Sprintf("%s.%s", key, tokenType))
+	}
+
+	return tokens
+}
+
 // readOutput reads and validates output config value
 func readOutput(v *viper.Viper) (OutputType, error) {
 	output := OutputType(v.GetString("out"))
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/require"
+)
+
+func testFlags(t *testing.T) *pflag.FlagSet {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.String("context", "", "")
+	flags.Bool("debug", false, "")
+	flags.Bool("yes", false, "")
+	flags.String("out", "text", "")
+	flags.String("config", filepath.Join(t.TempDir(), "config.yaml"), "")
+
+	return flags
+}
+
+func writeTestAuth(t *testing.T, flags *pflag.FlagSet, auth *AuthConfig) {
+	require.NoError(t, WriteConfig(&Config{
+		ContextConfig: map[string]*ContextConfig{
+			defaultCurrentContext: {Auth: auth},
+		},
+	}, flags))
+}
+
+func TestAuthCredentials(t *testing.T) {
+	flags := testFlags(t)
+
+	writeTestAuth(t, flags, &AuthConfig{
+		Tokens: map[AuthTokenType]string{AuthTokenTypeSession: "session-token"},
+	})
+	writeTestAuth(t, flags, &AuthConfig{
+		Credential: "work",
+		Tokens:     map[AuthTokenType]string{AuthTokenTypeAPI: "work-token"},
+	})
+
+	cfg, err := FromFlags(flags)
+	require.NoError(t, err)
+
+	auth := cfg.ContextConfig[defaultCurrentContext].Auth
+	require.Equal(t, "work", auth.CredentialName())
+	require.Len(t, auth.Credentials, 2)
+	require.Equal(t, "session-token", auth.Credentials[DefaultAuthCredential][AuthTokenTypeSession])
+
+	typ, value, ok := auth.Token()
+	require.True(t, ok)
+	require.Equal(t, AuthTokenTypeAPI, typ)
+	require.Equal(t, "work-token", value)
+
+	writeTestAuth(t, flags, &AuthConfig{Credential: DefaultAuthCredential})
+
+	cfg, err = FromFlags(flags)
+	require.NoError(t, err)
+
+	typ, value, ok = cfg.ContextConfig[defaultCurrentContext].Auth.Token()
+	require.True(t, ok)
+	require.Equal(t, AuthTokenTypeSession, typ)
+	require.Equal(t, "session-token", value)
+}
+
+func TestIsValidAuthCredentialName(t *testing.T) {
+	for _, name := range []string{"default", "work", "ci_bot-2"} {
+		require.True(t, IsValidAuthCredentialName(name), name)
+	}
+
+	for _, name := range []string{"", "Work", "a.b", "-x", "with space"} {
+		require.False(t, IsValidAuthCredentialName(name), name)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"k8s.io/apiserver/pkg/storage/names"
 	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	cachingv1alpha1 "knative.dev/caching/pkg/apis/caching/v1alpha1"
@@ -55,8 +56,9 @@ const (
 )
 
 type Client struct {
-	APIClient client.Client
-	Mapper    meta.RESTMapper
+	APIClient  client.Client
+	Mapper     meta.RESTMapper
+	RESTConfig *rest.Config
 }
 
 type ClientOptions struct {
@@ -192,6 +194,10 @@ func (m *Manager) CreateWorkflow(ctx context.Context, wd *v1.WorkflowData, t *re
 		return nil, err
 	}
 
+	if err := m.persistWebhookTriggers(ctx, name, wd, t); err != nil {
+		return nil, err
+	}
+
 	return mapping.Workflow, nil
 }
 
@@ -339,7 +345,8 @@ func NewClient(ctx context.Context, opts ClientOptions, apiConfig *clientcmdapi.
 	}
 
 	return &Client{
-		APIClient: c,
-		Mapper:    mapper,
+		APIClient:  c,
+		Mapper:     mapper,
+		RESTConfig: restConfig,
 	}, nil
 }
@@ -0,0 +1,236 @@
+package dev
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/puppetlabs/leg/httputil/serving"
+)
+
+// webhookConnectionHeaders are request headers that describe the connection a
+// webhook request arrived on rather than the request itself, so they are not
+// sent on when a request is forwarded or replayed.
+var webhookConnectionHeaders = map[string]bool{
+	"Host":              true,
+	"Content-Length":    true,
+	"Connection":        true,
+	"Transfer-Encoding": true,
+	"Accept-Encoding":   true,
+}
+
+// IsWebhookConnectionHeader returns true if the named header should not be
+// sent on when a webhook request is forwarded or replayed.
+func IsWebhookConnectionHeader(name string) bool {
+	return webhookConnectionHeaders[http.CanonicalHeaderKey(name)]
+}
+
+type WebhookCaptureOptions struct {
+	// Address is the host and port to listen on.
+	Address string
+
+	// Dir is the directory captured requests are written to.
+	Dir string
+
+	// ForwardURL is an optional webhook trigger endpoint to send each captured
+	// request on to.
+	ForwardURL string
+
+	// OnCapture is called after each request is captured.
+	OnCapture func(c *CapturedWebhookRequest)
+}
+
+// CapturedWebhookRequest describes a webhook request written to the capture
+// directory. The body and headers are written alongside the request so they
+// can be given to `relay workflow webhook replay` as --payload and --headers.
+type CapturedWebhookRequest struct {
+	Method      string    `json:"method"`
+	Path        string    `json:"path"`
+	Query       string    `json:"query,omitempty"`
+	ReceivedAt  time.Time `json:"received_at"`
+	BodyFile    string    `json:"body_file"`
+	HeadersFile string    `json:"headers_file"`
+
+	// ForwardStatus is the status of the response from the forwarding
+	// endpoint, if the request was forwarded.
+	ForwardStatus string `json:"forward_status,omitempty"`
+
+	// ForwardError describes why the request could not be forwarded.
+	ForwardError string `json:"forward_error,omitempty"`
+}
+
+// WebhookCaptureHandler records incoming webhook requests as fixture files.
+type WebhookCaptureHandler struct {
+	opts   WebhookCaptureOptions
+	client *http.Client
+
+	mut  sync.Mutex
+	next int
+}
+
+var _ http.Handler = &WebhookCaptureHandler{}
+
+func (h *WebhookCaptureHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c := &CapturedWebhookRequest{
+		Method:     r.Method,
+		Path:       r.URL.Path,
+		Query:      r.URL.RawQuery,
+		ReceivedAt: time.Now().UTC(),
+	}
+
+	var resp *http.Response
+
+	if h.opts.ForwardURL != "" {
+		resp, err = h.forward(r, body)
+		if err != nil {
+			c.ForwardError = err.Error()
+		} else {
+			defer resp.Body.Close()
+			c.ForwardStatus = resp.Status
+		}
+	}
+
+	if err := h.write(c, r.Header, body); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if h.opts.OnCapture != nil {
+		h.opts.OnCapture(c)
+	}
+
+	switch {
+	case c.ForwardError != "":
+		http.Error(w, c.ForwardError, http.StatusBadGateway)
+	case resp != nil:
+		for name, values := range resp.Header {
+			if IsWebhookConnectionHeader(name) {
+				continue
+			}
+
+			w.Header()[name] = values
+		}
+
+		w.WriteHeader(resp.StatusCode)
+		_, _ = io.Copy(w, resp.Body)
+	default:
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (h *WebhookCaptureHandler) forward(r *http.Request, body []byte) (*http.Response, error) {
+	target := h.opts.ForwardURL
+	if r.URL.RawQuery != "" {
+		target += "?" + r.URL.RawQuery
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	for name, values := range r.Header {
+		if IsWebhookConnectionHeader(name) {
+			continue
+		}
+
+		req.Header[name] = values
+	}
+
+	return h.client.Do(req)
+}
+
+// write stores a captured request as request-NNNN.json, with the body in
+// request-NNNN.body and the headers in request-NNNN.headers.json. Existing
+// captures in the directory are never overwritten.
+func (h *WebhookCaptureHandler) write(c *CapturedWebhookRequest, headers http.Header, body []byte) error {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	var prefix string
+	for {
+		h.next++
+
+		prefix = fmt.Sprintf("request-%04d", h.next)
+		if _, err := os.Stat(filepath.Join(h.opts.Dir, prefix+".json")); os.IsNotExist(err) {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+
+	c.BodyFile = prefix + ".body"
+	c.HeadersFile = prefix + ".headers.json"
+
+	hb, err := json.MarshalIndent(headers, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cb, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	files := []struct {
+		name    string
+		content []byte
+	}{
+		{c.BodyFile, body},
+		{c.HeadersFile, append(hb, '\n')},
+		{prefix + ".json", append(cb, '\n')},
+	}
+
+	for _, f := range files {
+		if err := ioutil.WriteFile(filepath.Join(h.opts.Dir, f.name), f.content, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewWebhookCaptureHandler(opts WebhookCaptureOptions) *WebhookCaptureHandler {
+	return &WebhookCaptureHandler{
+		opts:   opts,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// CaptureWebhooks listens for webhook requests and records them until the
+// context is done. The listening address is sent to addr once the listener is
+// ready.
+func CaptureWebhooks(ctx context.Context, opts WebhookCaptureOptions, addr chan<- string) error {
+	if err := os.MkdirAll(opts.Dir, 0755); err != nil {
+		return fmt.Errorf("could not create capture directory %s: %+v", opts.Dir, err)
+	}
+
+	s := &http.Server{
+		Handler: NewWebhookCaptureHandler(opts),
+		Addr:    opts.Address,
+		BaseContext: func(l net.Listener) context.Context {
+			if addr != nil {
+				addr <- l.Addr().String()
+			}
+
+			return context.Background()
+		},
+	}
+
+	return serving.ListenWaitHTTP(ctx, s)
+}
@@ -0,0 +1,86 @@
+package dev
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookCaptureHandler(t *testing.T) {
+	dir := t.TempDir()
+
+	h := NewWebhookCaptureHandler(WebhookCaptureOptions{Dir: dir})
+
+	req := httptest.NewRequest(http.MethodPost, "/hook?delivery=1", strings.NewReader(`{"action":"opened"}`))
+	req.Header.Set("X-GitHub-Event", "pull_request")
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	body, err := ioutil.ReadFile(filepath.Join(dir, "request-0001.body"))
+	require.NoError(t, err)
+	require.Equal(t, `{"action":"opened"}`, string(body))
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "request-0001.headers.json"))
+	require.NoError(t, err)
+
+	var headers http.Header
+	require.NoError(t, json.Unmarshal(b, &headers))
+	require.Equal(t, "pull_request", headers.Get("X-GitHub-Event"))
+
+	b, err = ioutil.ReadFile(filepath.Join(dir, "request-0001.json"))
+	require.NoError(t, err)
+
+	var c CapturedWebhookRequest
+	require.NoError(t, json.Unmarshal(b, &c))
+	require.Equal(t, http.MethodPost, c.Method)
+	require.Equal(t, "/hook", c.Path)
+	require.Equal(t, "delivery=1", c.Query)
+
+	// A new handler on the same directory does not overwrite earlier captures.
+	h = NewWebhookCaptureHandler(WebhookCaptureOptions{Dir: dir})
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader("second")))
+
+	body, err = ioutil.ReadFile(filepath.Join(dir, "request-0002.body"))
+	require.NoError(t, err)
+	require.Equal(t, "second", string(body))
+}
+
+func TestWebhookCaptureHandlerForward(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		require.Equal(t, "payload", string(b))
+		require.Equal(t, "push", r.Header.Get("X-GitHub-Event"))
+		require.Equal(t, "delivery=1", r.URL.RawQuery)
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("accepted"))
+	}))
+	defer upstream.Close()
+
+	var captured *CapturedWebhookRequest
+
+	h := NewWebhookCaptureHandler(WebhookCaptureOptions{
+		Dir:        t.TempDir(),
+		ForwardURL: upstream.URL,
+		OnCapture:  func(c *CapturedWebhookRequest) { captured = c },
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/?delivery=1", strings.NewReader("payload"))
+	req.Header.Set("X-GitHub-Event", "push")
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusAccepted, w.Code)
+	require.Equal(t, "accepted", w.Body.String())
+	require.NotNil(t, captured)
+	require.Equal(t, "202 Accepted", captured.ForwardStatus)
+}
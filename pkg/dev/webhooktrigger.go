@@ -0,0 +1,256 @@
+package dev
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	v1 "github.com/puppetlabs/relay-client-go/models/pkg/workflow/types/v1"
+	relayv1beta1 "github.com/puppetlabs/relay-core/pkg/apis/relay.sh/v1beta1"
+	"github.com/puppetlabs/relay-core/pkg/obj"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	kourierGatewayApp = "3scale-kourier-gateway"
+
+	// kourierInternalPort is the port of the gateway that routes requests to
+	// cluster-local Knative services, which is how webhook triggers are
+	// exposed.
+	kourierInternalPort = 8081
+)
+
+// WebhookTriggerEndpoint is a webhook trigger on the dev cluster made
+// reachable from the host by forwarding a local port to the ingress gateway of
+// the cluster.
+type WebhookTriggerEndpoint struct {
+	// URL is the local URL that requests to the trigger should be sent to.
+	URL string
+
+	// Host is the host name the gateway routes requests to the trigger by. It
+	// must be sent as the Host header of each request.
+	Host string
+
+	stop chan struct{}
+}
+
+// Close stops forwarding requests to the webhook trigger.
+func (e *WebhookTriggerEndpoint) Close() {
+	close(e.stop)
+}
+
+// ForwardWebhookTrigger makes a webhook trigger of a workflow on the dev
+// cluster reachable from the host. If trigger is empty, the workflow must have
+// exactly one webhook trigger. The returned endpoint must be closed when it is
+// no longer needed.
+func (m *Manager) ForwardWebhookTrigger(ctx context.Context, workflow, trigger string) (*WebhookTriggerEndpoint, error) {
+	wt, err := m.findWebhookTrigger(ctx, workflow, trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(wt.Status.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	pods := &corev1.PodList{}
+	if err := m.cl.APIClient.List(ctx, pods, client.InNamespace(kourierSystemNamespace), client.MatchingLabels{"app": kourierGatewayApp}); err != nil {
+		return nil, err
+	}
+
+	var gateway *corev1.Pod
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == corev1.PodRunning {
+			gateway = &pods.Items[i]
+			break
+		}
+	}
+
+	if gateway == nil {
+		return nil, fmt.Errorf("the ingress gateway of the dev cluster is not running")
+	}
+
+	cs, err := kubernetes.NewForConfig(m.cl.RESTConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	transport, upgrader, err := spdy.RoundTripperFor(m.cl.RESTConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	req := cs.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(gateway.GetNamespace()).
+		Name(gateway.GetName()).
+		SubResource("portforward")
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+
+	stop := make(chan struct{})
+	ready := make(chan struct{})
+
+	fw, err := portforward.NewOnAddresses(
+		dialer,
+		[]string{"127.0.0.1"},
+		[]string{fmt.Sprintf("0:%d", kourierInternalPort)},
+		stop, ready, ioutil.Discard, ioutil.Discard,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- fw.ForwardPorts()
+	}()
+
+	select {
+	case <-ready:
+	case err := <-errCh:
+		return nil, fmt.Errorf("could not forward a port to the ingress gateway of the dev cluster: %+v", err)
+	case <-ctx.Done():
+		close(stop)
+		return nil, ctx.Err()
+	}
+
+	ports, err := fw.GetPorts()
+	if err != nil {
+		close(stop)
+		return nil, err
+	}
+
+	return &WebhookTriggerEndpoint{
+		URL:  fmt.Sprintf("http://127.0.0.1:%d%s", ports[0].Local, u.RequestURI()),
+		Host: u.Host,
+		stop: stop,
+	}, nil
+}
+
+func (m *Manager) findWebhookTrigger(ctx context.Context, workflow, trigger string) (*relayv1beta1.WebhookTrigger, error) {
+	wtl := &relayv1beta1.WebhookTriggerList{}
+	if err := m.cl.APIClient.List(ctx, wtl, client.InNamespace(tenantNamespace)); err != nil {
+		return nil, err
+	}
+
+	var found []relayv1beta1.WebhookTrigger
+
+	for _, wt := range wtl.Items {
+		if wt.Spec.TenantRef.Name != workflow {
+			continue
+		}
+
+		if trigger == "" || wt.Spec.Name == trigger {
+			found = append(found, wt)
+		}
+	}
+
+	switch {
+	case len(found) == 0 && trigger != "":
+		return nil, fmt.Errorf("workflow %s has no webhook trigger named %s on the dev cluster", workflow, trigger)
+	case len(found) == 0:
+		return nil, fmt.Errorf("workflow %s has no webhook triggers on the dev cluster", workflow)
+	case len(found) > 1:
+		return nil, fmt.Errorf("workflow %s has more than one webhook trigger on the dev cluster; choose one by name", workflow)
+	case found[0].Status.URL == "":
+		return nil, fmt.Errorf("webhook trigger %s of workflow %s is not ready yet", found[0].Spec.Name, workflow)
+	}
+
+	return &found[0], nil
+}
+
+// persistWebhookTriggers saves the webhook triggers of a workflow to its
+// tenant and removes any triggers the workflow no longer defines.
+func (m *Manager) persistWebhookTriggers(ctx context.Context, name string, wd *v1.WorkflowData, t *relayv1beta1.Tenant) error {
+	// The owner reference of each trigger needs the UID of the tenant, so
+	// use the tenant as it is stored in the cluster.
+	tenant := obj.NewTenant(client.ObjectKey{
+		Name:      t.GetName(),
+		Namespace: t.GetNamespace(),
+	})
+	if ok, err := tenant.Load(ctx, m.cl.APIClient); err != nil {
+		return err
+	} else if !ok {
+		return fmt.Errorf("tenant %s does not exist", t.GetName())
+	}
+
+	keep := make(map[string]bool)
+
+	for _, trigger := range wd.Triggers {
+		if trigger.Source == nil {
+			continue
+		}
+
+		source, ok := trigger.Source.Variant.(*v1.WebhookWorkflowTriggerSource)
+		if !ok {
+			continue
+		}
+
+		mapper := v1.NewDefaultWebhookTriggerEngineMapper(
+			v1.WithIDWebhookTriggerOption(webhookTriggerID(name, trigger.Name)),
+			v1.WithNameWebhookTriggerOption(trigger.Name),
+			v1.WithWorkflowNameWebhookTriggerOption(name),
+			v1.WithImageWebhookTriggerOption(source.Image),
+			v1.WithDomainIDWebhookTriggerOption(name),
+			v1.WithVaultEngineMountWebhookTriggerOption(VaultEngineMountCustomers),
+		)
+
+		mapping, err := mapper.ToRuntimeObjectsManifest(tenant.Object, source)
+		if err != nil {
+			return err
+		}
+
+		key := client.ObjectKeyFromObject(mapping.WebhookTrigger)
+		keep[key.Name] = true
+
+		wt := obj.NewWebhookTrigger(key)
+		if _, err := wt.Load(ctx, m.cl.APIClient); err != nil {
+			return err
+		}
+
+		wt.Object.SetLabels(mapping.WebhookTrigger.GetLabels())
+		wt.Object.SetAnnotations(mapping.WebhookTrigger.GetAnnotations())
+		wt.Object.SetOwnerReferences(mapping.WebhookTrigger.GetOwnerReferences())
+		wt.Object.Spec = mapping.WebhookTrigger.Spec
+
+		if err := wt.Persist(ctx, m.cl.APIClient); err != nil {
+			return err
+		}
+	}
+
+	wtl := &relayv1beta1.WebhookTriggerList{}
+	if err := m.cl.APIClient.List(ctx, wtl, client.InNamespace(tenant.Key.Namespace)); err != nil {
+		return err
+	}
+
+	for i := range wtl.Items {
+		wt := &wtl.Items[i]
+		if wt.Spec.TenantRef.Name != tenant.Key.Name || keep[wt.GetName()] {
+			continue
+		}
+
+		if err := m.cl.APIClient.Delete(ctx, wt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// webhookTriggerID returns a stable ID for a webhook trigger that is safe to
+// use in object names and labels whatever the names of the workflow and
+// trigger are.
+func webhookTriggerID(workflow, trigger string) string {
+	sum := sha256.Sum256([]byte(workflow + "/" + trigger))
+	return hex.EncodeToString(sum[:16])
+}
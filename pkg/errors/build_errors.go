@@ -32,6 +32,58 @@ var AuthSection = &impl.ErrorSection{
 	Title: "Authentication errors",
 }
 
+// AuthExpiredTokenErrorCode is the code for an instance of "expired_token_error".
+const AuthExpiredTokenErrorCode = "rcli_auth_expired_token_error"
+
+// IsAuthExpiredTokenError tests whether a given error is an instance of "expired_token_error".
+func IsAuthExpiredTokenError(err errawr.Error) bool {
+	return err != nil && err.Is(AuthExpiredTokenErrorCode)
+}
+
+// IsAuthExpiredTokenError tests whether a given error is an instance of "expired_token_error".
+func (External) IsAuthExpiredTokenError(err errawr.Error) bool {
+	return IsAuthExpiredTokenError(err)
+}
+
+// AuthExpiredTokenErrorBuilder is a builder for "expired_token_error" errors.
+type AuthExpiredTokenErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "expired_token_error" from this builder.
+func (b *AuthExpiredTokenErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "The {{ type }} token of context {{ context }} expired at {{ expiry }}. Log in again with `relay auth login`.",
+		Technical: "The {{ type }} token of context {{ context }} expired at {{ expiry }}. Log in again with `relay auth login`.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "expired_token_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     AuthSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Expired token",
+		Version:          1,
+	}
+}
+
+// NewAuthExpiredTokenErrorBuilder creates a new error builder for the code "expired_token_error".
+func NewAuthExpiredTokenErrorBuilder(context string, type_ string, expiry string) *AuthExpiredTokenErrorBuilder {
+	return &AuthExpiredTokenErrorBuilder{arguments: impl.ErrorArguments{
+		"context": impl.NewErrorArgument(context, "The name of the context"),
+		"expiry":  impl.NewErrorArgument(expiry, "When the token expired"),
+		"type":    impl.NewErrorArgument(type_, "The type of the token"),
+	}}
+}
+
+// NewAuthExpiredTokenError creates a new error with the code "expired_token_error".
+func NewAuthExpiredTokenError(context string, type_ string, expiry string) Error {
+	return NewAuthExpiredTokenErrorBuilder(context, type_, expiry).Build()
+}
+
 // AuthFailedLoginErrorCode is the code for an instance of "failed_login_error".
 const AuthFailedLoginErrorCode = "rcli_auth_failed_login_error"
 
@@ -176,6 +228,105 @@ func NewAuthFailedPassFromStdin() Error {
 	return NewAuthFailedPassFromStdinBuilder().Build()
 }
 
+// AuthInvalidCredentialNameErrorCode is the code for an instance of "invalid_credential_name_error".
+const AuthInvalidCredentialNameErrorCode = "rcli_auth_invalid_credential_name_error"
+
+// IsAuthInvalidCredentialNameError tests whether a given error is an instance of "invalid_credential_name_error".
+func IsAuthInvalidCredentialNameError(err errawr.Error) bool {
+	return err != nil && err.Is(AuthInvalidCredentialNameErrorCode)
+}
+
+// IsAuthInvalidCredentialNameError tests whether a given error is an instance of "invalid_credential_name_error".
+func (External) IsAuthInvalidCredentialNameError(err errawr.Error) bool {
+	return IsAuthInvalidCredentialNameError(err)
+}
+
+// AuthInvalidCredentialNameErrorBuilder is a builder for "invalid_credential_name_error" errors.
+type AuthInvalidCredentialNameErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "invalid_credential_name_error" from this builder.
+func (b *AuthInvalidCredentialNameErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "{{ name }} is not a valid credential name. Use lower case letters, numbers, dashes and underscores.",
+		Technical: "{{ name }} is not a valid credential name. Use lower case letters, numbers, dashes and underscores.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "invalid_credential_name_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     AuthSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Invalid credential name",
+		Version:          1,
+	}
+}
+
+// NewAuthInvalidCredentialNameErrorBuilder creates a new error builder for the code "invalid_credential_name_error".
+func NewAuthInvalidCredentialNameErrorBuilder(name string) *AuthInvalidCredentialNameErrorBuilder {
+	return &AuthInvalidCredentialNameErrorBuilder{arguments: impl.ErrorArguments{"name": impl.NewErrorArgument(name, "User provided credential name")}}
+}
+
+// NewAuthInvalidCredentialNameError creates a new error with the code "invalid_credential_name_error".
+func NewAuthInvalidCredentialNameError(name string) Error {
+	return NewAuthInvalidCredentialNameErrorBuilder(name).Build()
+}
+
+// AuthInvalidTokenErrorCode is the code for an instance of "invalid_token_error".
+const AuthInvalidTokenErrorCode = "rcli_auth_invalid_token_error"
+
+// IsAuthInvalidTokenError tests whether a given error is an instance of "invalid_token_error".
+func IsAuthInvalidTokenError(err errawr.Error) bool {
+	return err != nil && err.Is(AuthInvalidTokenErrorCode)
+}
+
+// IsAuthInvalidTokenError tests whether a given error is an instance of "invalid_token_error".
+func (External) IsAuthInvalidTokenError(err errawr.Error) bool {
+	return IsAuthInvalidTokenError(err)
+}
+
+// AuthInvalidTokenErrorBuilder is a builder for "invalid_token_error" errors.
+type AuthInvalidTokenErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "invalid_token_error" from this builder.
+func (b *AuthInvalidTokenErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "The {{ type }} token of context {{ context }} was not accepted. Log in again with `relay auth login`.",
+		Technical: "The {{ type }} token of context {{ context }} was not accepted. Log in again with `relay auth login`.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "invalid_token_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     AuthSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Invalid token",
+		Version:          1,
+	}
+}
+
+// NewAuthInvalidTokenErrorBuilder creates a new error builder for the code "invalid_token_error".
+func NewAuthInvalidTokenErrorBuilder(context string, type_ string) *AuthInvalidTokenErrorBuilder {
+	return &AuthInvalidTokenErrorBuilder{arguments: impl.ErrorArguments{
+		"context": impl.NewErrorArgument(context, "The name of the context"),
+		"type":    impl.NewErrorArgument(type_, "The type of the token"),
+	}}
+}
+
+// NewAuthInvalidTokenError creates a new error with the code "invalid_token_error".
+func NewAuthInvalidTokenError(context string, type_ string) Error {
+	return NewAuthInvalidTokenErrorBuilder(context, type_).Build()
+}
+
 // AuthMismatchedEmailPassMethodsCode is the code for an instance of "mismatched_email_pass_methods".
 const AuthMismatchedEmailPassMethodsCode = "rcli_auth_mismatched_email_pass_methods"
 
@@ -224,6 +375,105 @@ func NewAuthMismatchedEmailPassMethods() Error {
 	return NewAuthMismatchedEmailPassMethodsBuilder().Build()
 }
 
+// AuthNotLoggedInErrorCode is the code for an instance of "not_logged_in_error".
+const AuthNotLoggedInErrorCode = "rcli_auth_not_logged_in_error"
+
+// IsAuthNotLoggedInError tests whether a given error is an instance of "not_logged_in_error".
+func IsAuthNotLoggedInError(err errawr.Error) bool {
+	return err != nil && err.Is(AuthNotLoggedInErrorCode)
+}
+
+// IsAuthNotLoggedInError tests whether a given error is an instance of "not_logged_in_error".
+func (External) IsAuthNotLoggedInError(err errawr.Error) bool {
+	return IsAuthNotLoggedInError(err)
+}
+
+// AuthNotLoggedInErrorBuilder is a builder for "not_logged_in_error" errors.
+type AuthNotLoggedInErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "not_logged_in_error" from this builder.
+func (b *AuthNotLoggedInErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "No credentials are stored for context {{ context }}. Log in with `relay auth login`.",
+		Technical: "No credentials are stored for context {{ context }}. Log in with `relay auth login`.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "not_logged_in_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     AuthSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Not logged in",
+		Version:          1,
+	}
+}
+
+// NewAuthNotLoggedInErrorBuilder creates a new error builder for the code "not_logged_in_error".
+func NewAuthNotLoggedInErrorBuilder(context string) *AuthNotLoggedInErrorBuilder {
+	return &AuthNotLoggedInErrorBuilder{arguments: impl.ErrorArguments{"context": impl.NewErrorArgument(context, "The name of the context")}}
+}
+
+// NewAuthNotLoggedInError creates a new error with the code "not_logged_in_error".
+func NewAuthNotLoggedInError(context string) Error {
+	return NewAuthNotLoggedInErrorBuilder(context).Build()
+}
+
+// AuthUnknownCredentialErrorCode is the code for an instance of "unknown_credential_error".
+const AuthUnknownCredentialErrorCode = "rcli_auth_unknown_credential_error"
+
+// IsAuthUnknownCredentialError tests whether a given error is an instance of "unknown_credential_error".
+func IsAuthUnknownCredentialError(err errawr.Error) bool {
+	return err != nil && err.Is(AuthUnknownCredentialErrorCode)
+}
+
+// IsAuthUnknownCredentialError tests whether a given error is an instance of "unknown_credential_error".
+func (External) IsAuthUnknownCredentialError(err errawr.Error) bool {
+	return IsAuthUnknownCredentialError(err)
+}
+
+// AuthUnknownCredentialErrorBuilder is a builder for "unknown_credential_error" errors.
+type AuthUnknownCredentialErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "unknown_credential_error" from this builder.
+func (b *AuthUnknownCredentialErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Context {{ context }} has no credential named {{ name }}. Add it with `relay auth login --name {{ name }}`.",
+		Technical: "Context {{ context }} has no credential named {{ name }}. Add it with `relay auth login --name {{ name }}`.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "unknown_credential_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     AuthSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Unknown credential",
+		Version:          1,
+	}
+}
+
+// NewAuthUnknownCredentialErrorBuilder creates a new error builder for the code "unknown_credential_error".
+func NewAuthUnknownCredentialErrorBuilder(context string, name string) *AuthUnknownCredentialErrorBuilder {
+	return &AuthUnknownCredentialErrorBuilder{arguments: impl.ErrorArguments{
+		"context": impl.NewErrorArgument(context, "The name of the context"),
+		"name":    impl.NewErrorArgument(name, "User provided credential name"),
+	}}
+}
+
+// NewAuthUnknownCredentialError creates a new error with the code "unknown_credential_error".
+func NewAuthUnknownCredentialError(context string, name string) Error {
+	return NewAuthUnknownCredentialErrorBuilder(context, name).Build()
+}
+
 // ClientSection defines a section of errors with the following scope:
 // Client errors
 var ClientSection = &impl.ErrorSection{
@@ -471,6 +721,54 @@ func NewClientRequestError() Error {
 	return NewClientRequestErrorBuilder().Build()
 }
 
+// ClientRequestRejectedCode is the code for an instance of "request_rejected".
+const ClientRequestRejectedCode = "rcli_client_request_rejected"
+
+// IsClientRequestRejected tests whether a given error is an instance of "request_rejected".
+func IsClientRequestRejected(err errawr.Error) bool {
+	return err != nil && err.Is(ClientRequestRejectedCode)
+}
+
+// IsClientRequestRejected tests whether a given error is an instance of "request_rejected".
+func (External) IsClientRequestRejected(err errawr.Error) bool {
+	return IsClientRequestRejected(err)
+}
+
+// ClientRequestRejectedBuilder is a builder for "request_rejected" errors.
+type ClientRequestRejectedBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "request_rejected" from this builder.
+func (b *ClientRequestRejectedBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Relay could not accept your request.",
+		Technical: "Relay could not accept your request.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "request_rejected",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     ClientSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Request rejected",
+		Version:          1,
+	}
+}
+
+// NewClientRequestRejectedBuilder creates a new error builder for the code "request_rejected".
+func NewClientRequestRejectedBuilder() *ClientRequestRejectedBuilder {
+	return &ClientRequestRejectedBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewClientRequestRejected creates a new error with the code "request_rejected".
+func NewClientRequestRejected() Error {
+	return NewClientRequestRejectedBuilder().Build()
+}
+
 // ClientResponseNotFoundCode is the code for an instance of "response_not_found".
 const ClientResponseNotFoundCode = "rcli_client_response_not_found"
 
@@ -1006,457 +1304,3521 @@ func NewConfigInvalidWebDomain(domain string) Error {
 	return NewConfigInvalidWebDomainBuilder(domain).Build()
 }
 
-// GeneralSection defines a section of errors with the following scope:
-// General errors
-var GeneralSection = &impl.ErrorSection{
-	Key:   "general",
-	Title: "General errors",
-}
-
-// GeneralUnknownErrorCode is the code for an instance of "unknown_error".
-const GeneralUnknownErrorCode = "rcli_general_unknown_error"
+// ConfigUnknownContextCode is the code for an instance of "unknown_context".
+const ConfigUnknownContextCode = "rcli_config_unknown_context"
 
-// IsGeneralUnknownError tests whether a given error is an instance of "unknown_error".
-func IsGeneralUnknownError(err errawr.Error) bool {
-	return err != nil && err.Is(GeneralUnknownErrorCode)
+// IsConfigUnknownContext tests whether a given error is an instance of "unknown_context".
+func IsConfigUnknownContext(err errawr.Error) bool {
+	return err != nil && err.Is(ConfigUnknownContextCode)
 }
 
-// IsGeneralUnknownError tests whether a given error is an instance of "unknown_error".
-func (External) IsGeneralUnknownError(err errawr.Error) bool {
-	return IsGeneralUnknownError(err)
+// IsConfigUnknownContext tests whether a given error is an instance of "unknown_context".
+func (External) IsConfigUnknownContext(err errawr.Error) bool {
+	return IsConfigUnknownContext(err)
 }
 
-// GeneralUnknownErrorBuilder is a builder for "unknown_error" errors.
-type GeneralUnknownErrorBuilder struct {
+// ConfigUnknownContextBuilder is a builder for "unknown_context" errors.
+type ConfigUnknownContextBuilder struct {
 	arguments impl.ErrorArguments
 }
 
-// Build creates the error for the code "unknown_error" from this builder.
-func (b *GeneralUnknownErrorBuilder) Build() Error {
+// Build creates the error for the code "unknown_context" from this builder.
+func (b *ConfigUnknownContextBuilder) Build() Error {
 	description := &impl.ErrorDescription{
-		Friendly:  "An unexpected error occurred.",
-		Technical: "An unexpected error occurred.",
+		Friendly:  "No configuration found for context {{ context }}.",
+		Technical: "No configuration found for context {{ context }}.",
 	}
 
 	return &impl.Error{
 		ErrorArguments:   b.arguments,
-		ErrorCode:        "unknown_error",
+		ErrorCode:        "unknown_context",
 		ErrorDescription: description,
 		ErrorDomain:      Domain,
 		ErrorMetadata:    &impl.ErrorMetadata{},
-		ErrorSection:     GeneralSection,
+		ErrorSection:     ConfigSection,
 		ErrorSensitivity: errawr.ErrorSensitivityNone,
-		ErrorTitle:       "Unknown error",
+		ErrorTitle:       "Unknown context",
 		Version:          1,
 	}
 }
 
-// NewGeneralUnknownErrorBuilder creates a new error builder for the code "unknown_error".
-func NewGeneralUnknownErrorBuilder() *GeneralUnknownErrorBuilder {
-	return &GeneralUnknownErrorBuilder{arguments: impl.ErrorArguments{}}
+// NewConfigUnknownContextBuilder creates a new error builder for the code "unknown_context".
+func NewConfigUnknownContextBuilder(context string) *ConfigUnknownContextBuilder {
+	return &ConfigUnknownContextBuilder{arguments: impl.ErrorArguments{"context": impl.NewErrorArgument(context, "User provided context name")}}
 }
 
-// NewGeneralUnknownError creates a new error with the code "unknown_error".
-func NewGeneralUnknownError() Error {
-	return NewGeneralUnknownErrorBuilder().Build()
+// NewConfigUnknownContext creates a new error with the code "unknown_context".
+func NewConfigUnknownContext(context string) Error {
+	return NewConfigUnknownContextBuilder(context).Build()
 }
 
-// SecretSection defines a section of errors with the following scope:
-// Secret errors
-var SecretSection = &impl.ErrorSection{
-	Key:   "secret",
-	Title: "Secret errors",
+// ConnectionSection defines a section of errors with the following scope:
+// Connection errors
+var ConnectionSection = &impl.ErrorSection{
+	Key:   "connection",
+	Title: "Connection errors",
 }
 
-// SecretFailedNoStdinCode is the code for an instance of "failed_no_stdin".
-const SecretFailedNoStdinCode = "rcli_secret_failed_no_stdin"
+// ConnectionFieldFileReadErrorCode is the code for an instance of "field_file_read_error".
+const ConnectionFieldFileReadErrorCode = "rcli_connection_field_file_read_error"
 
-// IsSecretFailedNoStdin tests whether a given error is an instance of "failed_no_stdin".
-func IsSecretFailedNoStdin(err errawr.Error) bool {
-	return err != nil && err.Is(SecretFailedNoStdinCode)
+// IsConnectionFieldFileReadError tests whether a given error is an instance of "field_file_read_error".
+func IsConnectionFieldFileReadError(err errawr.Error) bool {
+	return err != nil && err.Is(ConnectionFieldFileReadErrorCode)
 }
 
-// IsSecretFailedNoStdin tests whether a given error is an instance of "failed_no_stdin".
-func (External) IsSecretFailedNoStdin(err errawr.Error) bool {
-	return IsSecretFailedNoStdin(err)
+// IsConnectionFieldFileReadError tests whether a given error is an instance of "field_file_read_error".
+func (External) IsConnectionFieldFileReadError(err errawr.Error) bool {
+	return IsConnectionFieldFileReadError(err)
 }
 
-// SecretFailedNoStdinBuilder is a builder for "failed_no_stdin" errors.
-type SecretFailedNoStdinBuilder struct {
+// ConnectionFieldFileReadErrorBuilder is a builder for "field_file_read_error" errors.
+type ConnectionFieldFileReadErrorBuilder struct {
 	arguments impl.ErrorArguments
 }
 
-// Build creates the error for the code "failed_no_stdin" from this builder.
-func (b *SecretFailedNoStdinBuilder) Build() Error {
+// Build creates the error for the code "field_file_read_error" from this builder.
+func (b *ConnectionFieldFileReadErrorBuilder) Build() Error {
 	description := &impl.ErrorDescription{
-		Friendly:  "Did not receive anything from stdin.",
-		Technical: "Did not receive anything from stdin.",
+		Friendly:  "Could not read connection field value from {{ path }}.",
+		Technical: "Could not read connection field value from {{ path }}.",
 	}
 
 	return &impl.Error{
 		ErrorArguments:   b.arguments,
-		ErrorCode:        "failed_no_stdin",
+		ErrorCode:        "field_file_read_error",
 		ErrorDescription: description,
 		ErrorDomain:      Domain,
 		ErrorMetadata:    &impl.ErrorMetadata{},
-		ErrorSection:     SecretSection,
+		ErrorSection:     ConnectionSection,
 		ErrorSensitivity: errawr.ErrorSensitivityNone,
-		ErrorTitle:       "Did not receive from stdin error",
+		ErrorTitle:       "Connection field file read error",
 		Version:          1,
 	}
 }
 
-// NewSecretFailedNoStdinBuilder creates a new error builder for the code "failed_no_stdin".
-func NewSecretFailedNoStdinBuilder() *SecretFailedNoStdinBuilder {
-	return &SecretFailedNoStdinBuilder{arguments: impl.ErrorArguments{}}
+// NewConnectionFieldFileReadErrorBuilder creates a new error builder for the code "field_file_read_error".
+func NewConnectionFieldFileReadErrorBuilder(path string) *ConnectionFieldFileReadErrorBuilder {
+	return &ConnectionFieldFileReadErrorBuilder{arguments: impl.ErrorArguments{"path": impl.NewErrorArgument(path, "User provided field file")}}
 }
 
-// NewSecretFailedNoStdin creates a new error with the code "failed_no_stdin".
-func NewSecretFailedNoStdin() Error {
-	return NewSecretFailedNoStdinBuilder().Build()
+// NewConnectionFieldFileReadError creates a new error with the code "field_file_read_error".
+func NewConnectionFieldFileReadError(path string) Error {
+	return NewConnectionFieldFileReadErrorBuilder(path).Build()
 }
 
-// SecretFailedValueFromStdinCode is the code for an instance of "failed_value_from_stdin".
-const SecretFailedValueFromStdinCode = "rcli_secret_failed_value_from_stdin"
+// ConnectionFieldReadErrorCode is the code for an instance of "field_read_error".
+const ConnectionFieldReadErrorCode = "rcli_connection_field_read_error"
 
-// IsSecretFailedValueFromStdin tests whether a given error is an instance of "failed_value_from_stdin".
-func IsSecretFailedValueFromStdin(err errawr.Error) bool {
-	return err != nil && err.Is(SecretFailedValueFromStdinCode)
+// IsConnectionFieldReadError tests whether a given error is an instance of "field_read_error".
+func IsConnectionFieldReadError(err errawr.Error) bool {
+	return err != nil && err.Is(ConnectionFieldReadErrorCode)
 }
 
-// IsSecretFailedValueFromStdin tests whether a given error is an instance of "failed_value_from_stdin".
-func (External) IsSecretFailedValueFromStdin(err errawr.Error) bool {
-	return IsSecretFailedValueFromStdin(err)
+// IsConnectionFieldReadError tests whether a given error is an instance of "field_read_error".
+func (External) IsConnectionFieldReadError(err errawr.Error) bool {
+	return IsConnectionFieldReadError(err)
 }
 
-// SecretFailedValueFromStdinBuilder is a builder for "failed_value_from_stdin" errors.
-type SecretFailedValueFromStdinBuilder struct {
+// ConnectionFieldReadErrorBuilder is a builder for "field_read_error" errors.
+type ConnectionFieldReadErrorBuilder struct {
 	arguments impl.ErrorArguments
 }
 
-// Build creates the error for the code "failed_value_from_stdin" from this builder.
-func (b *SecretFailedValueFromStdinBuilder) Build() Error {
+// Build creates the error for the code "field_read_error" from this builder.
+func (b *ConnectionFieldReadErrorBuilder) Build() Error {
 	description := &impl.ErrorDescription{
-		Friendly:  "Could not read secret value from stdin.",
-		Technical: "Could not read secret value from stdin.",
+		Friendly:  "Could not read value for connection field {{ field }}.",
+		Technical: "Could not read value for connection field {{ field }}.",
 	}
 
 	return &impl.Error{
 		ErrorArguments:   b.arguments,
-		ErrorCode:        "failed_value_from_stdin",
+		ErrorCode:        "field_read_error",
 		ErrorDescription: description,
 		ErrorDomain:      Domain,
 		ErrorMetadata:    &impl.ErrorMetadata{},
-		ErrorSection:     SecretSection,
+		ErrorSection:     ConnectionSection,
 		ErrorSensitivity: errawr.ErrorSensitivityNone,
-		ErrorTitle:       "Failed value from stdin",
+		ErrorTitle:       "Connection field read error",
 		Version:          1,
 	}
 }
 
-// NewSecretFailedValueFromStdinBuilder creates a new error builder for the code "failed_value_from_stdin".
-func NewSecretFailedValueFromStdinBuilder() *SecretFailedValueFromStdinBuilder {
-	return &SecretFailedValueFromStdinBuilder{arguments: impl.ErrorArguments{}}
+// NewConnectionFieldReadErrorBuilder creates a new error builder for the code "field_read_error".
+func NewConnectionFieldReadErrorBuilder(field string) *ConnectionFieldReadErrorBuilder {
+	return &ConnectionFieldReadErrorBuilder{arguments: impl.ErrorArguments{"field": impl.NewErrorArgument(field, "The connection field being read")}}
 }
 
-// NewSecretFailedValueFromStdin creates a new error with the code "failed_value_from_stdin".
-func NewSecretFailedValueFromStdin() Error {
-	return NewSecretFailedValueFromStdinBuilder().Build()
+// NewConnectionFieldReadError creates a new error with the code "field_read_error".
+func NewConnectionFieldReadError(field string) Error {
+	return NewConnectionFieldReadErrorBuilder(field).Build()
 }
 
-// SecretMissingNameErrorCode is the code for an instance of "missing_name_error".
-const SecretMissingNameErrorCode = "rcli_secret_missing_name_error"
+// ConnectionInvalidFieldErrorCode is the code for an instance of "invalid_field_error".
+const ConnectionInvalidFieldErrorCode = "rcli_connection_invalid_field_error"
 
-// IsSecretMissingNameError tests whether a given error is an instance of "missing_name_error".
-func IsSecretMissingNameError(err errawr.Error) bool {
-	return err != nil && err.Is(SecretMissingNameErrorCode)
+// IsConnectionInvalidFieldError tests whether a given error is an instance of "invalid_field_error".
+func IsConnectionInvalidFieldError(err errawr.Error) bool {
+	return err != nil && err.Is(ConnectionInvalidFieldErrorCode)
 }
 
-// IsSecretMissingNameError tests whether a given error is an instance of "missing_name_error".
-func (External) IsSecretMissingNameError(err errawr.Error) bool {
-	return IsSecretMissingNameError(err)
+// IsConnectionInvalidFieldError tests whether a given error is an instance of "invalid_field_error".
+func (External) IsConnectionInvalidFieldError(err errawr.Error) bool {
+	return IsConnectionInvalidFieldError(err)
 }
 
-// SecretMissingNameErrorBuilder is a builder for "missing_name_error" errors.
-type SecretMissingNameErrorBuilder struct {
+// ConnectionInvalidFieldErrorBuilder is a builder for "invalid_field_error" errors.
+type ConnectionInvalidFieldErrorBuilder struct {
 	arguments impl.ErrorArguments
 }
 
-// Build creates the error for the code "missing_name_error" from this builder.
-func (b *SecretMissingNameErrorBuilder) Build() Error {
+// Build creates the error for the code "invalid_field_error" from this builder.
+func (b *ConnectionInvalidFieldErrorBuilder) Build() Error {
 	description := &impl.ErrorDescription{
-		Friendly:  "Please provide a workflow name.",
-		Technical: "Please provide a workflow name.",
+		Friendly:  "Could not parse connection field {{ field }}. Fields must be given as key=value.",
+		Technical: "Could not parse connection field {{ field }}. Fields must be given as key=value.",
 	}
 
 	return &impl.Error{
 		ErrorArguments:   b.arguments,
-		ErrorCode:        "missing_name_error",
+		ErrorCode:        "invalid_field_error",
 		ErrorDescription: description,
 		ErrorDomain:      Domain,
 		ErrorMetadata:    &impl.ErrorMetadata{},
-		ErrorSection:     SecretSection,
+		ErrorSection:     ConnectionSection,
 		ErrorSensitivity: errawr.ErrorSensitivityNone,
-		ErrorTitle:       "Missing workflow name error",
+		ErrorTitle:       "Invalid connection field",
 		Version:          1,
 	}
 }
 
-// NewSecretMissingNameErrorBuilder creates a new error builder for the code "missing_name_error".
+// NewConnectionInvalidFieldErrorBuilder creates a new error builder for the code "invalid_field_error".
+func NewConnectionInvalidFieldErrorBuilder(field string) *ConnectionInvalidFieldErrorBuilder {
+	return &ConnectionInvalidFieldErrorBuilder{arguments: impl.ErrorArguments{"field": impl.NewErrorArgument(field, "User provided field")}}
+}
+
+// NewConnectionInvalidFieldError creates a new error with the code "invalid_field_error".
+func NewConnectionInvalidFieldError(field string) Error {
+	return NewConnectionInvalidFieldErrorBuilder(field).Build()
+}
+
+// ConnectionMissingFieldsErrorCode is the code for an instance of "missing_fields_error".
+const ConnectionMissingFieldsErrorCode = "rcli_connection_missing_fields_error"
+
+// IsConnectionMissingFieldsError tests whether a given error is an instance of "missing_fields_error".
+func IsConnectionMissingFieldsError(err errawr.Error) bool {
+	return err != nil && err.Is(ConnectionMissingFieldsErrorCode)
+}
+
+// IsConnectionMissingFieldsError tests whether a given error is an instance of "missing_fields_error".
+func (External) IsConnectionMissingFieldsError(err errawr.Error) bool {
+	return IsConnectionMissingFieldsError(err)
+}
+
+// ConnectionMissingFieldsErrorBuilder is a builder for "missing_fields_error" errors.
+type ConnectionMissingFieldsErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "missing_fields_error" from this builder.
+func (b *ConnectionMissingFieldsErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Please provide the fields of the connection with --field or --field-file.",
+		Technical: "Please provide the fields of the connection with --field or --field-file.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "missing_fields_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     ConnectionSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Missing connection fields error",
+		Version:          1,
+	}
+}
+
+// NewConnectionMissingFieldsErrorBuilder creates a new error builder for the code "missing_fields_error".
+func NewConnectionMissingFieldsErrorBuilder() *ConnectionMissingFieldsErrorBuilder {
+	return &ConnectionMissingFieldsErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewConnectionMissingFieldsError creates a new error with the code "missing_fields_error".
+func NewConnectionMissingFieldsError() Error {
+	return NewConnectionMissingFieldsErrorBuilder().Build()
+}
+
+// ConnectionMissingNameErrorCode is the code for an instance of "missing_name_error".
+const ConnectionMissingNameErrorCode = "rcli_connection_missing_name_error"
+
+// IsConnectionMissingNameError tests whether a given error is an instance of "missing_name_error".
+func IsConnectionMissingNameError(err errawr.Error) bool {
+	return err != nil && err.Is(ConnectionMissingNameErrorCode)
+}
+
+// IsConnectionMissingNameError tests whether a given error is an instance of "missing_name_error".
+func (External) IsConnectionMissingNameError(err errawr.Error) bool {
+	return IsConnectionMissingNameError(err)
+}
+
+// ConnectionMissingNameErrorBuilder is a builder for "missing_name_error" errors.
+type ConnectionMissingNameErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "missing_name_error" from this builder.
+func (b *ConnectionMissingNameErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Please provide a connection name.",
+		Technical: "Please provide a connection name.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "missing_name_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     ConnectionSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Missing connection name error",
+		Version:          1,
+	}
+}
+
+// NewConnectionMissingNameErrorBuilder creates a new error builder for the code "missing_name_error".
+func NewConnectionMissingNameErrorBuilder() *ConnectionMissingNameErrorBuilder {
+	return &ConnectionMissingNameErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewConnectionMissingNameError creates a new error with the code "missing_name_error".
+func NewConnectionMissingNameError() Error {
+	return NewConnectionMissingNameErrorBuilder().Build()
+}
+
+// ConnectionMissingTypeErrorCode is the code for an instance of "missing_type_error".
+const ConnectionMissingTypeErrorCode = "rcli_connection_missing_type_error"
+
+// IsConnectionMissingTypeError tests whether a given error is an instance of "missing_type_error".
+func IsConnectionMissingTypeError(err errawr.Error) bool {
+	return err != nil && err.Is(ConnectionMissingTypeErrorCode)
+}
+
+// IsConnectionMissingTypeError tests whether a given error is an instance of "missing_type_error".
+func (External) IsConnectionMissingTypeError(err errawr.Error) bool {
+	return IsConnectionMissingTypeError(err)
+}
+
+// ConnectionMissingTypeErrorBuilder is a builder for "missing_type_error" errors.
+type ConnectionMissingTypeErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "missing_type_error" from this builder.
+func (b *ConnectionMissingTypeErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Please provide a connection type, such as aws, gcp, azure or kubernetes.",
+		Technical: "Please provide a connection type, such as aws, gcp, azure or kubernetes.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "missing_type_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     ConnectionSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Missing connection type error",
+		Version:          1,
+	}
+}
+
+// NewConnectionMissingTypeErrorBuilder creates a new error builder for the code "missing_type_error".
+func NewConnectionMissingTypeErrorBuilder() *ConnectionMissingTypeErrorBuilder {
+	return &ConnectionMissingTypeErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewConnectionMissingTypeError creates a new error with the code "missing_type_error".
+func NewConnectionMissingTypeError() Error {
+	return NewConnectionMissingTypeErrorBuilder().Build()
+}
+
+// ConnectionNameReadErrorCode is the code for an instance of "name_read_error".
+const ConnectionNameReadErrorCode = "rcli_connection_name_read_error"
+
+// IsConnectionNameReadError tests whether a given error is an instance of "name_read_error".
+func IsConnectionNameReadError(err errawr.Error) bool {
+	return err != nil && err.Is(ConnectionNameReadErrorCode)
+}
+
+// IsConnectionNameReadError tests whether a given error is an instance of "name_read_error".
+func (External) IsConnectionNameReadError(err errawr.Error) bool {
+	return IsConnectionNameReadError(err)
+}
+
+// ConnectionNameReadErrorBuilder is a builder for "name_read_error" errors.
+type ConnectionNameReadErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "name_read_error" from this builder.
+func (b *ConnectionNameReadErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not read connection name. Please supply a valid name.",
+		Technical: "Could not read connection name. Please supply a valid name.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "name_read_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     ConnectionSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Connection name read error",
+		Version:          1,
+	}
+}
+
+// NewConnectionNameReadErrorBuilder creates a new error builder for the code "name_read_error".
+func NewConnectionNameReadErrorBuilder() *ConnectionNameReadErrorBuilder {
+	return &ConnectionNameReadErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewConnectionNameReadError creates a new error with the code "name_read_error".
+func NewConnectionNameReadError() Error {
+	return NewConnectionNameReadErrorBuilder().Build()
+}
+
+// ConnectionNotFoundErrorCode is the code for an instance of "not_found_error".
+const ConnectionNotFoundErrorCode = "rcli_connection_not_found_error"
+
+// IsConnectionNotFoundError tests whether a given error is an instance of "not_found_error".
+func IsConnectionNotFoundError(err errawr.Error) bool {
+	return err != nil && err.Is(ConnectionNotFoundErrorCode)
+}
+
+// IsConnectionNotFoundError tests whether a given error is an instance of "not_found_error".
+func (External) IsConnectionNotFoundError(err errawr.Error) bool {
+	return IsConnectionNotFoundError(err)
+}
+
+// ConnectionNotFoundErrorBuilder is a builder for "not_found_error" errors.
+type ConnectionNotFoundErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "not_found_error" from this builder.
+func (b *ConnectionNotFoundErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "No {{ type }} connection named {{ name }} was found.",
+		Technical: "No {{ type }} connection named {{ name }} was found.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "not_found_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     ConnectionSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Connection not found",
+		Version:          1,
+	}
+}
+
+// NewConnectionNotFoundErrorBuilder creates a new error builder for the code "not_found_error".
+func NewConnectionNotFoundErrorBuilder(type_ string, name string) *ConnectionNotFoundErrorBuilder {
+	return &ConnectionNotFoundErrorBuilder{arguments: impl.ErrorArguments{
+		"name": impl.NewErrorArgument(name, "The connection name"),
+		"type": impl.NewErrorArgument(type_, "The connection type"),
+	}}
+}
+
+// NewConnectionNotFoundError creates a new error with the code "not_found_error".
+func NewConnectionNotFoundError(type_ string, name string) Error {
+	return NewConnectionNotFoundErrorBuilder(type_, name).Build()
+}
+
+// ConnectionTypeReadErrorCode is the code for an instance of "type_read_error".
+const ConnectionTypeReadErrorCode = "rcli_connection_type_read_error"
+
+// IsConnectionTypeReadError tests whether a given error is an instance of "type_read_error".
+func IsConnectionTypeReadError(err errawr.Error) bool {
+	return err != nil && err.Is(ConnectionTypeReadErrorCode)
+}
+
+// IsConnectionTypeReadError tests whether a given error is an instance of "type_read_error".
+func (External) IsConnectionTypeReadError(err errawr.Error) bool {
+	return IsConnectionTypeReadError(err)
+}
+
+// ConnectionTypeReadErrorBuilder is a builder for "type_read_error" errors.
+type ConnectionTypeReadErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "type_read_error" from this builder.
+func (b *ConnectionTypeReadErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not read connection type. Please supply a valid type.",
+		Technical: "Could not read connection type. Please supply a valid type.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "type_read_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     ConnectionSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Connection type read error",
+		Version:          1,
+	}
+}
+
+// NewConnectionTypeReadErrorBuilder creates a new error builder for the code "type_read_error".
+func NewConnectionTypeReadErrorBuilder() *ConnectionTypeReadErrorBuilder {
+	return &ConnectionTypeReadErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewConnectionTypeReadError creates a new error with the code "type_read_error".
+func NewConnectionTypeReadError() Error {
+	return NewConnectionTypeReadErrorBuilder().Build()
+}
+
+// GeneralSection defines a section of errors with the following scope:
+// General errors
+var GeneralSection = &impl.ErrorSection{
+	Key:   "general",
+	Title: "General errors",
+}
+
+// GeneralUnknownErrorCode is the code for an instance of "unknown_error".
+const GeneralUnknownErrorCode = "rcli_general_unknown_error"
+
+// IsGeneralUnknownError tests whether a given error is an instance of "unknown_error".
+func IsGeneralUnknownError(err errawr.Error) bool {
+	return err != nil && err.Is(GeneralUnknownErrorCode)
+}
+
+// IsGeneralUnknownError tests whether a given error is an instance of "unknown_error".
+func (External) IsGeneralUnknownError(err errawr.Error) bool {
+	return IsGeneralUnknownError(err)
+}
+
+// GeneralUnknownErrorBuilder is a builder for "unknown_error" errors.
+type GeneralUnknownErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "unknown_error" from this builder.
+func (b *GeneralUnknownErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "An unexpected error occurred.",
+		Technical: "An unexpected error occurred.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "unknown_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     GeneralSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Unknown error",
+		Version:          1,
+	}
+}
+
+// NewGeneralUnknownErrorBuilder creates a new error builder for the code "unknown_error".
+func NewGeneralUnknownErrorBuilder() *GeneralUnknownErrorBuilder {
+	return &GeneralUnknownErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewGeneralUnknownError creates a new error with the code "unknown_error".
+func NewGeneralUnknownError() Error {
+	return NewGeneralUnknownErrorBuilder().Build()
+}
+
+// SecretSection defines a section of errors with the following scope:
+// Secret errors
+var SecretSection = &impl.ErrorSection{
+	Key:   "secret",
+	Title: "Secret errors",
+}
+
+// SecretCheckFailedErrorCode is the code for an instance of "check_failed_error".
+const SecretCheckFailedErrorCode = "rcli_secret_check_failed_error"
+
+// IsSecretCheckFailedError tests whether a given error is an instance of "check_failed_error".
+func IsSecretCheckFailedError(err errawr.Error) bool {
+	return err != nil && err.Is(SecretCheckFailedErrorCode)
+}
+
+// IsSecretCheckFailedError tests whether a given error is an instance of "check_failed_error".
+func (External) IsSecretCheckFailedError(err errawr.Error) bool {
+	return IsSecretCheckFailedError(err)
+}
+
+// SecretCheckFailedErrorBuilder is a builder for "check_failed_error" errors.
+type SecretCheckFailedErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "check_failed_error" from this builder.
+func (b *SecretCheckFailedErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Found {{ count }} secrets that are used by workflow steps but not set.",
+		Technical: "Found {{ count }} secrets that are used by workflow steps but not set.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "check_failed_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Secret check failed",
+		Version:          1,
+	}
+}
+
+// NewSecretCheckFailedErrorBuilder creates a new error builder for the code "check_failed_error".
+func NewSecretCheckFailedErrorBuilder(count string) *SecretCheckFailedErrorBuilder {
+	return &SecretCheckFailedErrorBuilder{arguments: impl.ErrorArguments{"count": impl.NewErrorArgument(count, "The number of missing secrets")}}
+}
+
+// NewSecretCheckFailedError creates a new error with the code "check_failed_error".
+func NewSecretCheckFailedError(count string) Error {
+	return NewSecretCheckFailedErrorBuilder(count).Build()
+}
+
+// SecretConflictingValueSourcesErrorCode is the code for an instance of "conflicting_value_sources_error".
+const SecretConflictingValueSourcesErrorCode = "rcli_secret_conflicting_value_sources_error"
+
+// IsSecretConflictingValueSourcesError tests whether a given error is an instance of "conflicting_value_sources_error".
+func IsSecretConflictingValueSourcesError(err errawr.Error) bool {
+	return err != nil && err.Is(SecretConflictingValueSourcesErrorCode)
+}
+
+// IsSecretConflictingValueSourcesError tests whether a given error is an instance of "conflicting_value_sources_error".
+func (External) IsSecretConflictingValueSourcesError(err errawr.Error) bool {
+	return IsSecretConflictingValueSourcesError(err)
+}
+
+// SecretConflictingValueSourcesErrorBuilder is a builder for "conflicting_value_sources_error" errors.
+type SecretConflictingValueSourcesErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "conflicting_value_sources_error" from this builder.
+func (b *SecretConflictingValueSourcesErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Only one of --value-stdin, --from-file, --from-env and --from-exec may be used.",
+		Technical: "Only one of --value-stdin, --from-file, --from-env and --from-exec may be used.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "conflicting_value_sources_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Conflicting secret value sources error",
+		Version:          1,
+	}
+}
+
+// NewSecretConflictingValueSourcesErrorBuilder creates a new error builder for the code "conflicting_value_sources_error".
+func NewSecretConflictingValueSourcesErrorBuilder() *SecretConflictingValueSourcesErrorBuilder {
+	return &SecretConflictingValueSourcesErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewSecretConflictingValueSourcesError creates a new error with the code "conflicting_value_sources_error".
+func NewSecretConflictingValueSourcesError() Error {
+	return NewSecretConflictingValueSourcesErrorBuilder().Build()
+}
+
+// SecretCopySameWorkflowErrorCode is the code for an instance of "copy_same_workflow_error".
+const SecretCopySameWorkflowErrorCode = "rcli_secret_copy_same_workflow_error"
+
+// IsSecretCopySameWorkflowError tests whether a given error is an instance of "copy_same_workflow_error".
+func IsSecretCopySameWorkflowError(err errawr.Error) bool {
+	return err != nil && err.Is(SecretCopySameWorkflowErrorCode)
+}
+
+// IsSecretCopySameWorkflowError tests whether a given error is an instance of "copy_same_workflow_error".
+func (External) IsSecretCopySameWorkflowError(err errawr.Error) bool {
+	return IsSecretCopySameWorkflowError(err)
+}
+
+// SecretCopySameWorkflowErrorBuilder is a builder for "copy_same_workflow_error" errors.
+type SecretCopySameWorkflowErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "copy_same_workflow_error" from this builder.
+func (b *SecretCopySameWorkflowErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Cannot copy secrets from workflow {{ workflow }} to itself in the same context.",
+		Technical: "Cannot copy secrets from workflow {{ workflow }} to itself in the same context.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "copy_same_workflow_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Secret copy to same workflow error",
+		Version:          1,
+	}
+}
+
+// NewSecretCopySameWorkflowErrorBuilder creates a new error builder for the code "copy_same_workflow_error".
+func NewSecretCopySameWorkflowErrorBuilder(workflow string) *SecretCopySameWorkflowErrorBuilder {
+	return &SecretCopySameWorkflowErrorBuilder{arguments: impl.ErrorArguments{"workflow": impl.NewErrorArgument(workflow, "The source and target workflow name")}}
+}
+
+// NewSecretCopySameWorkflowError creates a new error with the code "copy_same_workflow_error".
+func NewSecretCopySameWorkflowError(workflow string) Error {
+	return NewSecretCopySameWorkflowErrorBuilder(workflow).Build()
+}
+
+// SecretCopySecretNotFoundErrorCode is the code for an instance of "copy_secret_not_found_error".
+const SecretCopySecretNotFoundErrorCode = "rcli_secret_copy_secret_not_found_error"
+
+// IsSecretCopySecretNotFoundError tests whether a given error is an instance of "copy_secret_not_found_error".
+func IsSecretCopySecretNotFoundError(err errawr.Error) bool {
+	return err != nil && err.Is(SecretCopySecretNotFoundErrorCode)
+}
+
+// IsSecretCopySecretNotFoundError tests whether a given error is an instance of "copy_secret_not_found_error".
+func (External) IsSecretCopySecretNotFoundError(err errawr.Error) bool {
+	return IsSecretCopySecretNotFoundError(err)
+}
+
+// SecretCopySecretNotFoundErrorBuilder is a builder for "copy_secret_not_found_error" errors.
+type SecretCopySecretNotFoundErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "copy_secret_not_found_error" from this builder.
+func (b *SecretCopySecretNotFoundErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Secret {{ name }} is not set on workflow {{ workflow }}.",
+		Technical: "Secret {{ name }} is not set on workflow {{ workflow }}.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "copy_secret_not_found_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Secret to copy not found",
+		Version:          1,
+	}
+}
+
+// NewSecretCopySecretNotFoundErrorBuilder creates a new error builder for the code "copy_secret_not_found_error".
+func NewSecretCopySecretNotFoundErrorBuilder(name string, workflow string) *SecretCopySecretNotFoundErrorBuilder {
+	return &SecretCopySecretNotFoundErrorBuilder{arguments: impl.ErrorArguments{
+		"name":     impl.NewErrorArgument(name, "User provided secret name"),
+		"workflow": impl.NewErrorArgument(workflow, "The source workflow name"),
+	}}
+}
+
+// NewSecretCopySecretNotFoundError creates a new error with the code "copy_secret_not_found_error".
+func NewSecretCopySecretNotFoundError(name string, workflow string) Error {
+	return NewSecretCopySecretNotFoundErrorBuilder(name, workflow).Build()
+}
+
+// SecretFailedNoStdinCode is the code for an instance of "failed_no_stdin".
+const SecretFailedNoStdinCode = "rcli_secret_failed_no_stdin"
+
+// IsSecretFailedNoStdin tests whether a given error is an instance of "failed_no_stdin".
+func IsSecretFailedNoStdin(err errawr.Error) bool {
+	return err != nil && err.Is(SecretFailedNoStdinCode)
+}
+
+// IsSecretFailedNoStdin tests whether a given error is an instance of "failed_no_stdin".
+func (External) IsSecretFailedNoStdin(err errawr.Error) bool {
+	return IsSecretFailedNoStdin(err)
+}
+
+// SecretFailedNoStdinBuilder is a builder for "failed_no_stdin" errors.
+type SecretFailedNoStdinBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "failed_no_stdin" from this builder.
+func (b *SecretFailedNoStdinBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Did not receive anything from stdin.",
+		Technical: "Did not receive anything from stdin.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "failed_no_stdin",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Did not receive from stdin error",
+		Version:          1,
+	}
+}
+
+// NewSecretFailedNoStdinBuilder creates a new error builder for the code "failed_no_stdin".
+func NewSecretFailedNoStdinBuilder() *SecretFailedNoStdinBuilder {
+	return &SecretFailedNoStdinBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewSecretFailedNoStdin creates a new error with the code "failed_no_stdin".
+func NewSecretFailedNoStdin() Error {
+	return NewSecretFailedNoStdinBuilder().Build()
+}
+
+// SecretFailedValueFromStdinCode is the code for an instance of "failed_value_from_stdin".
+const SecretFailedValueFromStdinCode = "rcli_secret_failed_value_from_stdin"
+
+// IsSecretFailedValueFromStdin tests whether a given error is an instance of "failed_value_from_stdin".
+func IsSecretFailedValueFromStdin(err errawr.Error) bool {
+	return err != nil && err.Is(SecretFailedValueFromStdinCode)
+}
+
+// IsSecretFailedValueFromStdin tests whether a given error is an instance of "failed_value_from_stdin".
+func (External) IsSecretFailedValueFromStdin(err errawr.Error) bool {
+	return IsSecretFailedValueFromStdin(err)
+}
+
+// SecretFailedValueFromStdinBuilder is a builder for "failed_value_from_stdin" errors.
+type SecretFailedValueFromStdinBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "failed_value_from_stdin" from this builder.
+func (b *SecretFailedValueFromStdinBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not read secret value from stdin.",
+		Technical: "Could not read secret value from stdin.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "failed_value_from_stdin",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Failed value from stdin",
+		Version:          1,
+	}
+}
+
+// NewSecretFailedValueFromStdinBuilder creates a new error builder for the code "failed_value_from_stdin".
+func NewSecretFailedValueFromStdinBuilder() *SecretFailedValueFromStdinBuilder {
+	return &SecretFailedValueFromStdinBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewSecretFailedValueFromStdin creates a new error with the code "failed_value_from_stdin".
+func NewSecretFailedValueFromStdin() Error {
+	return NewSecretFailedValueFromStdinBuilder().Build()
+}
+
+// SecretImportFileDecodeErrorCode is the code for an instance of "import_file_decode_error".
+const SecretImportFileDecodeErrorCode = "rcli_secret_import_file_decode_error"
+
+// IsSecretImportFileDecodeError tests whether a given error is an instance of "import_file_decode_error".
+func IsSecretImportFileDecodeError(err errawr.Error) bool {
+	return err != nil && err.Is(SecretImportFileDecodeErrorCode)
+}
+
+// IsSecretImportFileDecodeError tests whether a given error is an instance of "import_file_decode_error".
+func (External) IsSecretImportFileDecodeError(err errawr.Error) bool {
+	return IsSecretImportFileDecodeError(err)
+}
+
+// SecretImportFileDecodeErrorBuilder is a builder for "import_file_decode_error" errors.
+type SecretImportFileDecodeErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "import_file_decode_error" from this builder.
+func (b *SecretImportFileDecodeErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not decode secrets from {{ path }}. Use a dotenv (.env), JSON (.json) or YAML (.yaml) file with a value for each secret name.",
+		Technical: "Could not decode secrets from {{ path }}. Use a dotenv (.env), JSON (.json) or YAML (.yaml) file with a value for each secret name.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "import_file_decode_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Secret import file decode error",
+		Version:          1,
+	}
+}
+
+// NewSecretImportFileDecodeErrorBuilder creates a new error builder for the code "import_file_decode_error".
+func NewSecretImportFileDecodeErrorBuilder(path string) *SecretImportFileDecodeErrorBuilder {
+	return &SecretImportFileDecodeErrorBuilder{arguments: impl.ErrorArguments{"path": impl.NewErrorArgument(path, "User provided secrets file")}}
+}
+
+// NewSecretImportFileDecodeError creates a new error with the code "import_file_decode_error".
+func NewSecretImportFileDecodeError(path string) Error {
+	return NewSecretImportFileDecodeErrorBuilder(path).Build()
+}
+
+// SecretImportFileReadErrorCode is the code for an instance of "import_file_read_error".
+const SecretImportFileReadErrorCode = "rcli_secret_import_file_read_error"
+
+// IsSecretImportFileReadError tests whether a given error is an instance of "import_file_read_error".
+func IsSecretImportFileReadError(err errawr.Error) bool {
+	return err != nil && err.Is(SecretImportFileReadErrorCode)
+}
+
+// IsSecretImportFileReadError tests whether a given error is an instance of "import_file_read_error".
+func (External) IsSecretImportFileReadError(err errawr.Error) bool {
+	return IsSecretImportFileReadError(err)
+}
+
+// SecretImportFileReadErrorBuilder is a builder for "import_file_read_error" errors.
+type SecretImportFileReadErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "import_file_read_error" from this builder.
+func (b *SecretImportFileReadErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not read secrets from {{ path }}.",
+		Technical: "Could not read secrets from {{ path }}.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "import_file_read_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Secret import file read error",
+		Version:          1,
+	}
+}
+
+// NewSecretImportFileReadErrorBuilder creates a new error builder for the code "import_file_read_error".
+func NewSecretImportFileReadErrorBuilder(path string) *SecretImportFileReadErrorBuilder {
+	return &SecretImportFileReadErrorBuilder{arguments: impl.ErrorArguments{"path": impl.NewErrorArgument(path, "User provided secrets file")}}
+}
+
+// NewSecretImportFileReadError creates a new error with the code "import_file_read_error".
+func NewSecretImportFileReadError(path string) Error {
+	return NewSecretImportFileReadErrorBuilder(path).Build()
+}
+
+// SecretMissingCopySourceErrorCode is the code for an instance of "missing_copy_source_error".
+const SecretMissingCopySourceErrorCode = "rcli_secret_missing_copy_source_error"
+
+// IsSecretMissingCopySourceError tests whether a given error is an instance of "missing_copy_source_error".
+func IsSecretMissingCopySourceError(err errawr.Error) bool {
+	return err != nil && err.Is(SecretMissingCopySourceErrorCode)
+}
+
+// IsSecretMissingCopySourceError tests whether a given error is an instance of "missing_copy_source_error".
+func (External) IsSecretMissingCopySourceError(err errawr.Error) bool {
+	return IsSecretMissingCopySourceError(err)
+}
+
+// SecretMissingCopySourceErrorBuilder is a builder for "missing_copy_source_error" errors.
+type SecretMissingCopySourceErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "missing_copy_source_error" from this builder.
+func (b *SecretMissingCopySourceErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Please provide the workflow to copy secrets from with --from.",
+		Technical: "Please provide the workflow to copy secrets from with --from.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "missing_copy_source_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Missing secret copy source error",
+		Version:          1,
+	}
+}
+
+// NewSecretMissingCopySourceErrorBuilder creates a new error builder for the code "missing_copy_source_error".
+func NewSecretMissingCopySourceErrorBuilder() *SecretMissingCopySourceErrorBuilder {
+	return &SecretMissingCopySourceErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewSecretMissingCopySourceError creates a new error with the code "missing_copy_source_error".
+func NewSecretMissingCopySourceError() Error {
+	return NewSecretMissingCopySourceErrorBuilder().Build()
+}
+
+// SecretMissingImportFileErrorCode is the code for an instance of "missing_import_file_error".
+const SecretMissingImportFileErrorCode = "rcli_secret_missing_import_file_error"
+
+// IsSecretMissingImportFileError tests whether a given error is an instance of "missing_import_file_error".
+func IsSecretMissingImportFileError(err errawr.Error) bool {
+	return err != nil && err.Is(SecretMissingImportFileErrorCode)
+}
+
+// IsSecretMissingImportFileError tests whether a given error is an instance of "missing_import_file_error".
+func (External) IsSecretMissingImportFileError(err errawr.Error) bool {
+	return IsSecretMissingImportFileError(err)
+}
+
+// SecretMissingImportFileErrorBuilder is a builder for "missing_import_file_error" errors.
+type SecretMissingImportFileErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "missing_import_file_error" from this builder.
+func (b *SecretMissingImportFileErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Please provide a file to import secrets from with --from.",
+		Technical: "Please provide a file to import secrets from with --from.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "missing_import_file_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Missing secret import file error",
+		Version:          1,
+	}
+}
+
+// NewSecretMissingImportFileErrorBuilder creates a new error builder for the code "missing_import_file_error".
+func NewSecretMissingImportFileErrorBuilder() *SecretMissingImportFileErrorBuilder {
+	return &SecretMissingImportFileErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewSecretMissingImportFileError creates a new error with the code "missing_import_file_error".
+func NewSecretMissingImportFileError() Error {
+	return NewSecretMissingImportFileErrorBuilder().Build()
+}
+
+// SecretMissingNameErrorCode is the code for an instance of "missing_name_error".
+const SecretMissingNameErrorCode = "rcli_secret_missing_name_error"
+
+// IsSecretMissingNameError tests whether a given error is an instance of "missing_name_error".
+func IsSecretMissingNameError(err errawr.Error) bool {
+	return err != nil && err.Is(SecretMissingNameErrorCode)
+}
+
+// IsSecretMissingNameError tests whether a given error is an instance of "missing_name_error".
+func (External) IsSecretMissingNameError(err errawr.Error) bool {
+	return IsSecretMissingNameError(err)
+}
+
+// SecretMissingNameErrorBuilder is a builder for "missing_name_error" errors.
+type SecretMissingNameErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "missing_name_error" from this builder.
+func (b *SecretMissingNameErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Please provide a workflow name.",
+		Technical: "Please provide a workflow name.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "missing_name_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Missing workflow name error",
+		Version:          1,
+	}
+}
+
+// NewSecretMissingNameErrorBuilder creates a new error builder for the code "missing_name_error".
 func NewSecretMissingNameErrorBuilder() *SecretMissingNameErrorBuilder {
 	return &SecretMissingNameErrorBuilder{arguments: impl.ErrorArguments{}}
 }
 
-// NewSecretMissingNameError creates a new error with the code "missing_name_error".
-func NewSecretMissingNameError() Error {
-	return NewSecretMissingNameErrorBuilder().Build()
+// NewSecretMissingNameError creates a new error with the code "missing_name_error".
+func NewSecretMissingNameError() Error {
+	return NewSecretMissingNameErrorBuilder().Build()
+}
+
+// SecretNameReadErrorCode is the code for an instance of "name_read_error".
+const SecretNameReadErrorCode = "rcli_secret_name_read_error"
+
+// IsSecretNameReadError tests whether a given error is an instance of "name_read_error".
+func IsSecretNameReadError(err errawr.Error) bool {
+	return err != nil && err.Is(SecretNameReadErrorCode)
+}
+
+// IsSecretNameReadError tests whether a given error is an instance of "name_read_error".
+func (External) IsSecretNameReadError(err errawr.Error) bool {
+	return IsSecretNameReadError(err)
+}
+
+// SecretNameReadErrorBuilder is a builder for "name_read_error" errors.
+type SecretNameReadErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "name_read_error" from this builder.
+func (b *SecretNameReadErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not read secret name. Please supply a valid name.",
+		Technical: "Could not read secret name. Please supply a valid name.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "name_read_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Secret name read error",
+		Version:          1,
+	}
+}
+
+// NewSecretNameReadErrorBuilder creates a new error builder for the code "name_read_error".
+func NewSecretNameReadErrorBuilder() *SecretNameReadErrorBuilder {
+	return &SecretNameReadErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewSecretNameReadError creates a new error with the code "name_read_error".
+func NewSecretNameReadError() Error {
+	return NewSecretNameReadErrorBuilder().Build()
+}
+
+// SecretValueEnvNotSetErrorCode is the code for an instance of "value_env_not_set_error".
+const SecretValueEnvNotSetErrorCode = "rcli_secret_value_env_not_set_error"
+
+// IsSecretValueEnvNotSetError tests whether a given error is an instance of "value_env_not_set_error".
+func IsSecretValueEnvNotSetError(err errawr.Error) bool {
+	return err != nil && err.Is(SecretValueEnvNotSetErrorCode)
+}
+
+// IsSecretValueEnvNotSetError tests whether a given error is an instance of "value_env_not_set_error".
+func (External) IsSecretValueEnvNotSetError(err errawr.Error) bool {
+	return IsSecretValueEnvNotSetError(err)
+}
+
+// SecretValueEnvNotSetErrorBuilder is a builder for "value_env_not_set_error" errors.
+type SecretValueEnvNotSetErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "value_env_not_set_error" from this builder.
+func (b *SecretValueEnvNotSetErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "The environment variable {{ name }} is not set.",
+		Technical: "The environment variable {{ name }} is not set.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "value_env_not_set_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Secret value environment variable not set",
+		Version:          1,
+	}
+}
+
+// NewSecretValueEnvNotSetErrorBuilder creates a new error builder for the code "value_env_not_set_error".
+func NewSecretValueEnvNotSetErrorBuilder(name string) *SecretValueEnvNotSetErrorBuilder {
+	return &SecretValueEnvNotSetErrorBuilder{arguments: impl.ErrorArguments{"name": impl.NewErrorArgument(name, "User provided environment variable name")}}
+}
+
+// NewSecretValueEnvNotSetError creates a new error with the code "value_env_not_set_error".
+func NewSecretValueEnvNotSetError(name string) Error {
+	return NewSecretValueEnvNotSetErrorBuilder(name).Build()
+}
+
+// SecretValueExecErrorCode is the code for an instance of "value_exec_error".
+const SecretValueExecErrorCode = "rcli_secret_value_exec_error"
+
+// IsSecretValueExecError tests whether a given error is an instance of "value_exec_error".
+func IsSecretValueExecError(err errawr.Error) bool {
+	return err != nil && err.Is(SecretValueExecErrorCode)
+}
+
+// IsSecretValueExecError tests whether a given error is an instance of "value_exec_error".
+func (External) IsSecretValueExecError(err errawr.Error) bool {
+	return IsSecretValueExecError(err)
+}
+
+// SecretValueExecErrorBuilder is a builder for "value_exec_error" errors.
+type SecretValueExecErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "value_exec_error" from this builder.
+func (b *SecretValueExecErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not read secret value from the output of command {{ command }}.",
+		Technical: "Could not read secret value from the output of command {{ command }}.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "value_exec_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Secret value command error",
+		Version:          1,
+	}
+}
+
+// NewSecretValueExecErrorBuilder creates a new error builder for the code "value_exec_error".
+func NewSecretValueExecErrorBuilder(command string) *SecretValueExecErrorBuilder {
+	return &SecretValueExecErrorBuilder{arguments: impl.ErrorArguments{"command": impl.NewErrorArgument(command, "User provided command")}}
+}
+
+// NewSecretValueExecError creates a new error with the code "value_exec_error".
+func NewSecretValueExecError(command string) Error {
+	return NewSecretValueExecErrorBuilder(command).Build()
+}
+
+// SecretValueFileReadErrorCode is the code for an instance of "value_file_read_error".
+const SecretValueFileReadErrorCode = "rcli_secret_value_file_read_error"
+
+// IsSecretValueFileReadError tests whether a given error is an instance of "value_file_read_error".
+func IsSecretValueFileReadError(err errawr.Error) bool {
+	return err != nil && err.Is(SecretValueFileReadErrorCode)
+}
+
+// IsSecretValueFileReadError tests whether a given error is an instance of "value_file_read_error".
+func (External) IsSecretValueFileReadError(err errawr.Error) bool {
+	return IsSecretValueFileReadError(err)
+}
+
+// SecretValueFileReadErrorBuilder is a builder for "value_file_read_error" errors.
+type SecretValueFileReadErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "value_file_read_error" from this builder.
+func (b *SecretValueFileReadErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not read secret value from {{ path }}.",
+		Technical: "Could not read secret value from {{ path }}.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "value_file_read_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Secret value file read error",
+		Version:          1,
+	}
+}
+
+// NewSecretValueFileReadErrorBuilder creates a new error builder for the code "value_file_read_error".
+func NewSecretValueFileReadErrorBuilder(path string) *SecretValueFileReadErrorBuilder {
+	return &SecretValueFileReadErrorBuilder{arguments: impl.ErrorArguments{"path": impl.NewErrorArgument(path, "User provided secret value file")}}
+}
+
+// NewSecretValueFileReadError creates a new error with the code "value_file_read_error".
+func NewSecretValueFileReadError(path string) Error {
+	return NewSecretValueFileReadErrorBuilder(path).Build()
+}
+
+// SecretValueTooLargeErrorCode is the code for an instance of "value_too_large_error".
+const SecretValueTooLargeErrorCode = "rcli_secret_value_too_large_error"
+
+// IsSecretValueTooLargeError tests whether a given error is an instance of "value_too_large_error".
+func IsSecretValueTooLargeError(err errawr.Error) bool {
+	return err != nil && err.Is(SecretValueTooLargeErrorCode)
+}
+
+// IsSecretValueTooLargeError tests whether a given error is an instance of "value_too_large_error".
+func (External) IsSecretValueTooLargeError(err errawr.Error) bool {
+	return IsSecretValueTooLargeError(err)
+}
+
+// SecretValueTooLargeErrorBuilder is a builder for "value_too_large_error" errors.
+type SecretValueTooLargeErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "value_too_large_error" from this builder.
+func (b *SecretValueTooLargeErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Secret values may be at most {{ limit }} bytes.",
+		Technical: "Secret values may be at most {{ limit }} bytes.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "value_too_large_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     SecretSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Secret value too large",
+		Version:          1,
+	}
+}
+
+// NewSecretValueTooLargeErrorBuilder creates a new error builder for the code "value_too_large_error".
+func NewSecretValueTooLargeErrorBuilder(limit string) *SecretValueTooLargeErrorBuilder {
+	return &SecretValueTooLargeErrorBuilder{arguments: impl.ErrorArguments{"limit": impl.NewErrorArgument(limit, "The maximum size of a secret value")}}
+}
+
+// NewSecretValueTooLargeError creates a new error with the code "value_too_large_error".
+func NewSecretValueTooLargeError(limit string) Error {
+	return NewSecretValueTooLargeErrorBuilder(limit).Build()
+}
+
+// WorkflowSection defines a section of errors with the following scope:
+// Workflow errors
+var WorkflowSection = &impl.ErrorSection{
+	Key:   "workflow",
+	Title: "Workflow errors",
+}
+
+// WorkflowAlreadyExistsErrorCode is the code for an instance of "already_exists_error".
+const WorkflowAlreadyExistsErrorCode = "rcli_workflow_already_exists_error"
+
+// IsWorkflowAlreadyExistsError tests whether a given error is an instance of "already_exists_error".
+func IsWorkflowAlreadyExistsError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowAlreadyExistsErrorCode)
+}
+
+// IsWorkflowAlreadyExistsError tests whether a given error is an instance of "already_exists_error".
+func (External) IsWorkflowAlreadyExistsError(err errawr.Error) bool {
+	return IsWorkflowAlreadyExistsError(err)
+}
+
+// WorkflowAlreadyExistsErrorBuilder is a builder for "already_exists_error" errors.
+type WorkflowAlreadyExistsErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "already_exists_error" from this builder.
+func (b *WorkflowAlreadyExistsErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "A workflow with the name provided already exists. Please provide a new name.",
+		Technical: "A workflow with the name provided already exists. Please provide a new name.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "already_exists_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Workflow name already exists",
+		Version:          1,
+	}
+}
+
+// NewWorkflowAlreadyExistsErrorBuilder creates a new error builder for the code "already_exists_error".
+func NewWorkflowAlreadyExistsErrorBuilder() *WorkflowAlreadyExistsErrorBuilder {
+	return &WorkflowAlreadyExistsErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewWorkflowAlreadyExistsError creates a new error with the code "already_exists_error".
+func NewWorkflowAlreadyExistsError() Error {
+	return NewWorkflowAlreadyExistsErrorBuilder().Build()
+}
+
+// WorkflowApplyDirReadErrorCode is the code for an instance of "apply_dir_read_error".
+const WorkflowApplyDirReadErrorCode = "rcli_workflow_apply_dir_read_error"
+
+// IsWorkflowApplyDirReadError tests whether a given error is an instance of "apply_dir_read_error".
+func IsWorkflowApplyDirReadError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowApplyDirReadErrorCode)
+}
+
+// IsWorkflowApplyDirReadError tests whether a given error is an instance of "apply_dir_read_error".
+func (External) IsWorkflowApplyDirReadError(err errawr.Error) bool {
+	return IsWorkflowApplyDirReadError(err)
+}
+
+// WorkflowApplyDirReadErrorBuilder is a builder for "apply_dir_read_error" errors.
+type WorkflowApplyDirReadErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "apply_dir_read_error" from this builder.
+func (b *WorkflowApplyDirReadErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not read workflow files from directory {{ path }}.",
+		Technical: "Could not read workflow files from directory {{ path }}.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "apply_dir_read_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Apply directory read error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowApplyDirReadErrorBuilder creates a new error builder for the code "apply_dir_read_error".
+func NewWorkflowApplyDirReadErrorBuilder(path string) *WorkflowApplyDirReadErrorBuilder {
+	return &WorkflowApplyDirReadErrorBuilder{arguments: impl.ErrorArguments{"path": impl.NewErrorArgument(path, "User provided workflow directory")}}
+}
+
+// NewWorkflowApplyDirReadError creates a new error with the code "apply_dir_read_error".
+func NewWorkflowApplyDirReadError(path string) Error {
+	return NewWorkflowApplyDirReadErrorBuilder(path).Build()
+}
+
+// WorkflowApplyDuplicateWorkflowErrorCode is the code for an instance of "apply_duplicate_workflow_error".
+const WorkflowApplyDuplicateWorkflowErrorCode = "rcli_workflow_apply_duplicate_workflow_error"
+
+// IsWorkflowApplyDuplicateWorkflowError tests whether a given error is an instance of "apply_duplicate_workflow_error".
+func IsWorkflowApplyDuplicateWorkflowError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowApplyDuplicateWorkflowErrorCode)
+}
+
+// IsWorkflowApplyDuplicateWorkflowError tests whether a given error is an instance of "apply_duplicate_workflow_error".
+func (External) IsWorkflowApplyDuplicateWorkflowError(err errawr.Error) bool {
+	return IsWorkflowApplyDuplicateWorkflowError(err)
+}
+
+// WorkflowApplyDuplicateWorkflowErrorBuilder is a builder for "apply_duplicate_workflow_error" errors.
+type WorkflowApplyDuplicateWorkflowErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "apply_duplicate_workflow_error" from this builder.
+func (b *WorkflowApplyDuplicateWorkflowErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Both {{ first }} and {{ second }} define workflow {{ name }}. Remove one of them.",
+		Technical: "Both {{ first }} and {{ second }} define workflow {{ name }}. Remove one of them.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "apply_duplicate_workflow_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Duplicate workflow file",
+		Version:          1,
+	}
+}
+
+// NewWorkflowApplyDuplicateWorkflowErrorBuilder creates a new error builder for the code "apply_duplicate_workflow_error".
+func NewWorkflowApplyDuplicateWorkflowErrorBuilder(name string, first string, second string) *WorkflowApplyDuplicateWorkflowErrorBuilder {
+	return &WorkflowApplyDuplicateWorkflowErrorBuilder{arguments: impl.ErrorArguments{
+		"first":  impl.NewErrorArgument(first, "The path of the first file"),
+		"name":   impl.NewErrorArgument(name, "The name of the workflow"),
+		"second": impl.NewErrorArgument(second, "The path of the second file"),
+	}}
+}
+
+// NewWorkflowApplyDuplicateWorkflowError creates a new error with the code "apply_duplicate_workflow_error".
+func NewWorkflowApplyDuplicateWorkflowError(name string, first string, second string) Error {
+	return NewWorkflowApplyDuplicateWorkflowErrorBuilder(name, first, second).Build()
+}
+
+// WorkflowConflictingRollbackFlagsErrorCode is the code for an instance of "conflicting_rollback_flags_error".
+const WorkflowConflictingRollbackFlagsErrorCode = "rcli_workflow_conflicting_rollback_flags_error"
+
+// IsWorkflowConflictingRollbackFlagsError tests whether a given error is an instance of "conflicting_rollback_flags_error".
+func IsWorkflowConflictingRollbackFlagsError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowConflictingRollbackFlagsErrorCode)
+}
+
+// IsWorkflowConflictingRollbackFlagsError tests whether a given error is an instance of "conflicting_rollback_flags_error".
+func (External) IsWorkflowConflictingRollbackFlagsError(err errawr.Error) bool {
+	return IsWorkflowConflictingRollbackFlagsError(err)
+}
+
+// WorkflowConflictingRollbackFlagsErrorBuilder is a builder for "conflicting_rollback_flags_error" errors.
+type WorkflowConflictingRollbackFlagsErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "conflicting_rollback_flags_error" from this builder.
+func (b *WorkflowConflictingRollbackFlagsErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Specify either --to or --steps-back, not both.",
+		Technical: "Specify either --to or --steps-back, not both.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "conflicting_rollback_flags_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Conflicting rollback flags",
+		Version:          1,
+	}
+}
+
+// NewWorkflowConflictingRollbackFlagsErrorBuilder creates a new error builder for the code "conflicting_rollback_flags_error".
+func NewWorkflowConflictingRollbackFlagsErrorBuilder() *WorkflowConflictingRollbackFlagsErrorBuilder {
+	return &WorkflowConflictingRollbackFlagsErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewWorkflowConflictingRollbackFlagsError creates a new error with the code "conflicting_rollback_flags_error".
+func NewWorkflowConflictingRollbackFlagsError() Error {
+	return NewWorkflowConflictingRollbackFlagsErrorBuilder().Build()
+}
+
+// WorkflowDoesNotExistErrorCode is the code for an instance of "does_not_exist_error".
+const WorkflowDoesNotExistErrorCode = "rcli_workflow_does_not_exist_error"
+
+// IsWorkflowDoesNotExistError tests whether a given error is an instance of "does_not_exist_error".
+func IsWorkflowDoesNotExistError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowDoesNotExistErrorCode)
+}
+
+// IsWorkflowDoesNotExistError tests whether a given error is an instance of "does_not_exist_error".
+func (External) IsWorkflowDoesNotExistError(err errawr.Error) bool {
+	return IsWorkflowDoesNotExistError(err)
+}
+
+// WorkflowDoesNotExistErrorBuilder is a builder for "does_not_exist_error" errors.
+type WorkflowDoesNotExistErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "does_not_exist_error" from this builder.
+func (b *WorkflowDoesNotExistErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "A workflow with the name provided does not exist. Please choose an existing workflow.",
+		Technical: "A workflow with the name provided does not exist. Please choose an existing workflow.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "does_not_exist_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Workflow name does not exist",
+		Version:          1,
+	}
+}
+
+// NewWorkflowDoesNotExistErrorBuilder creates a new error builder for the code "does_not_exist_error".
+func NewWorkflowDoesNotExistErrorBuilder() *WorkflowDoesNotExistErrorBuilder {
+	return &WorkflowDoesNotExistErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewWorkflowDoesNotExistError creates a new error with the code "does_not_exist_error".
+func NewWorkflowDoesNotExistError() Error {
+	return NewWorkflowDoesNotExistErrorBuilder().Build()
+}
+
+// WorkflowExportDirCreateErrorCode is the code for an instance of "export_dir_create_error".
+const WorkflowExportDirCreateErrorCode = "rcli_workflow_export_dir_create_error"
+
+// IsWorkflowExportDirCreateError tests whether a given error is an instance of "export_dir_create_error".
+func IsWorkflowExportDirCreateError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowExportDirCreateErrorCode)
+}
+
+// IsWorkflowExportDirCreateError tests whether a given error is an instance of "export_dir_create_error".
+func (External) IsWorkflowExportDirCreateError(err errawr.Error) bool {
+	return IsWorkflowExportDirCreateError(err)
+}
+
+// WorkflowExportDirCreateErrorBuilder is a builder for "export_dir_create_error" errors.
+type WorkflowExportDirCreateErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "export_dir_create_error" from this builder.
+func (b *WorkflowExportDirCreateErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not create export directory {{ path }}.",
+		Technical: "Could not create export directory {{ path }}.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "export_dir_create_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Export directory create error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowExportDirCreateErrorBuilder creates a new error builder for the code "export_dir_create_error".
+func NewWorkflowExportDirCreateErrorBuilder(path string) *WorkflowExportDirCreateErrorBuilder {
+	return &WorkflowExportDirCreateErrorBuilder{arguments: impl.ErrorArguments{"path": impl.NewErrorArgument(path, "User provided export directory")}}
+}
+
+// NewWorkflowExportDirCreateError creates a new error with the code "export_dir_create_error".
+func NewWorkflowExportDirCreateError(path string) Error {
+	return NewWorkflowExportDirCreateErrorBuilder(path).Build()
+}
+
+// WorkflowExportFileWriteErrorCode is the code for an instance of "export_file_write_error".
+const WorkflowExportFileWriteErrorCode = "rcli_workflow_export_file_write_error"
+
+// IsWorkflowExportFileWriteError tests whether a given error is an instance of "export_file_write_error".
+func IsWorkflowExportFileWriteError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowExportFileWriteErrorCode)
+}
+
+// IsWorkflowExportFileWriteError tests whether a given error is an instance of "export_file_write_error".
+func (External) IsWorkflowExportFileWriteError(err errawr.Error) bool {
+	return IsWorkflowExportFileWriteError(err)
+}
+
+// WorkflowExportFileWriteErrorBuilder is a builder for "export_file_write_error" errors.
+type WorkflowExportFileWriteErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "export_file_write_error" from this builder.
+func (b *WorkflowExportFileWriteErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not write exported workflow file {{ path }}.",
+		Technical: "Could not write exported workflow file {{ path }}.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "export_file_write_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Export file write error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowExportFileWriteErrorBuilder creates a new error builder for the code "export_file_write_error".
+func NewWorkflowExportFileWriteErrorBuilder(path string) *WorkflowExportFileWriteErrorBuilder {
+	return &WorkflowExportFileWriteErrorBuilder{arguments: impl.ErrorArguments{"path": impl.NewErrorArgument(path, "Path of the file being written")}}
+}
+
+// NewWorkflowExportFileWriteError creates a new error with the code "export_file_write_error".
+func NewWorkflowExportFileWriteError(path string) Error {
+	return NewWorkflowExportFileWriteErrorBuilder(path).Build()
+}
+
+// WorkflowFmtCheckFailedErrorCode is the code for an instance of "fmt_check_failed_error".
+const WorkflowFmtCheckFailedErrorCode = "rcli_workflow_fmt_check_failed_error"
+
+// IsWorkflowFmtCheckFailedError tests whether a given error is an instance of "fmt_check_failed_error".
+func IsWorkflowFmtCheckFailedError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowFmtCheckFailedErrorCode)
+}
+
+// IsWorkflowFmtCheckFailedError tests whether a given error is an instance of "fmt_check_failed_error".
+func (External) IsWorkflowFmtCheckFailedError(err errawr.Error) bool {
+	return IsWorkflowFmtCheckFailedError(err)
+}
+
+// WorkflowFmtCheckFailedErrorBuilder is a builder for "fmt_check_failed_error" errors.
+type WorkflowFmtCheckFailedErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "fmt_check_failed_error" from this builder.
+func (b *WorkflowFmtCheckFailedErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "{{ count }} workflow files are not formatted. Run `relay workflow fmt --write` to format them.",
+		Technical: "{{ count }} workflow files are not formatted. Run `relay workflow fmt --write` to format them.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "fmt_check_failed_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Workflow files are not formatted",
+		Version:          1,
+	}
+}
+
+// NewWorkflowFmtCheckFailedErrorBuilder creates a new error builder for the code "fmt_check_failed_error".
+func NewWorkflowFmtCheckFailedErrorBuilder(count string) *WorkflowFmtCheckFailedErrorBuilder {
+	return &WorkflowFmtCheckFailedErrorBuilder{arguments: impl.ErrorArguments{"count": impl.NewErrorArgument(count, "The number of unformatted files")}}
+}
+
+// NewWorkflowFmtCheckFailedError creates a new error with the code "fmt_check_failed_error".
+func NewWorkflowFmtCheckFailedError(count string) Error {
+	return NewWorkflowFmtCheckFailedErrorBuilder(count).Build()
+}
+
+// WorkflowInvalidFindingsFormatErrorCode is the code for an instance of "invalid_findings_format_error".
+const WorkflowInvalidFindingsFormatErrorCode = "rcli_workflow_invalid_findings_format_error"
+
+// IsWorkflowInvalidFindingsFormatError tests whether a given error is an instance of "invalid_findings_format_error".
+func IsWorkflowInvalidFindingsFormatError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowInvalidFindingsFormatErrorCode)
+}
+
+// IsWorkflowInvalidFindingsFormatError tests whether a given error is an instance of "invalid_findings_format_error".
+func (External) IsWorkflowInvalidFindingsFormatError(err errawr.Error) bool {
+	return IsWorkflowInvalidFindingsFormatError(err)
+}
+
+// WorkflowInvalidFindingsFormatErrorBuilder is a builder for "invalid_findings_format_error" errors.
+type WorkflowInvalidFindingsFormatErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "invalid_findings_format_error" from this builder.
+func (b *WorkflowInvalidFindingsFormatErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Unknown value '{{ format }}' provided as findings format. Allowed values are 'text', 'json' and 'sarif'.",
+		Technical: "Unknown value '{{ format }}' provided as findings format. Allowed values are 'text', 'json' and 'sarif'.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "invalid_findings_format_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Invalid findings format",
+		Version:          1,
+	}
+}
+
+// NewWorkflowInvalidFindingsFormatErrorBuilder creates a new error builder for the code "invalid_findings_format_error".
+func NewWorkflowInvalidFindingsFormatErrorBuilder(format string) *WorkflowInvalidFindingsFormatErrorBuilder {
+	return &WorkflowInvalidFindingsFormatErrorBuilder{arguments: impl.ErrorArguments{"format": impl.NewErrorArgument(format, "User provided findings format")}}
+}
+
+// NewWorkflowInvalidFindingsFormatError creates a new error with the code "invalid_findings_format_error".
+func NewWorkflowInvalidFindingsFormatError(format string) Error {
+	return NewWorkflowInvalidFindingsFormatErrorBuilder(format).Build()
+}
+
+// WorkflowInvalidGraphFormatErrorCode is the code for an instance of "invalid_graph_format_error".
+const WorkflowInvalidGraphFormatErrorCode = "rcli_workflow_invalid_graph_format_error"
+
+// IsWorkflowInvalidGraphFormatError tests whether a given error is an instance of "invalid_graph_format_error".
+func IsWorkflowInvalidGraphFormatError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowInvalidGraphFormatErrorCode)
+}
+
+// IsWorkflowInvalidGraphFormatError tests whether a given error is an instance of "invalid_graph_format_error".
+func (External) IsWorkflowInvalidGraphFormatError(err errawr.Error) bool {
+	return IsWorkflowInvalidGraphFormatError(err)
+}
+
+// WorkflowInvalidGraphFormatErrorBuilder is a builder for "invalid_graph_format_error" errors.
+type WorkflowInvalidGraphFormatErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "invalid_graph_format_error" from this builder.
+func (b *WorkflowInvalidGraphFormatErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Unknown value '{{ format }}' provided as graph format. Allowed values are 'mermaid', 'dot' and 'ascii'.",
+		Technical: "Unknown value '{{ format }}' provided as graph format. Allowed values are 'mermaid', 'dot' and 'ascii'.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "invalid_graph_format_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Invalid graph format",
+		Version:          1,
+	}
+}
+
+// NewWorkflowInvalidGraphFormatErrorBuilder creates a new error builder for the code "invalid_graph_format_error".
+func NewWorkflowInvalidGraphFormatErrorBuilder(format string) *WorkflowInvalidGraphFormatErrorBuilder {
+	return &WorkflowInvalidGraphFormatErrorBuilder{arguments: impl.ErrorArguments{"format": impl.NewErrorArgument(format, "User provided graph format")}}
+}
+
+// NewWorkflowInvalidGraphFormatError creates a new error with the code "invalid_graph_format_error".
+func NewWorkflowInvalidGraphFormatError(format string) Error {
+	return NewWorkflowInvalidGraphFormatErrorBuilder(format).Build()
+}
+
+// WorkflowInvalidParameterErrorCode is the code for an instance of "invalid_parameter_error".
+const WorkflowInvalidParameterErrorCode = "rcli_workflow_invalid_parameter_error"
+
+// IsWorkflowInvalidParameterError tests whether a given error is an instance of "invalid_parameter_error".
+func IsWorkflowInvalidParameterError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowInvalidParameterErrorCode)
+}
+
+// IsWorkflowInvalidParameterError tests whether a given error is an instance of "invalid_parameter_error".
+func (External) IsWorkflowInvalidParameterError(err errawr.Error) bool {
+	return IsWorkflowInvalidParameterError(err)
+}
+
+// WorkflowInvalidParameterErrorBuilder is a builder for "invalid_parameter_error" errors.
+type WorkflowInvalidParameterErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "invalid_parameter_error" from this builder.
+func (b *WorkflowInvalidParameterErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not parse parameter '{{ parameter }}'. Parameters must be in the form key=value.",
+		Technical: "Could not parse parameter '{{ parameter }}'. Parameters must be in the form key=value.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "invalid_parameter_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Invalid parameter",
+		Version:          1,
+	}
+}
+
+// NewWorkflowInvalidParameterErrorBuilder creates a new error builder for the code "invalid_parameter_error".
+func NewWorkflowInvalidParameterErrorBuilder(parameter string) *WorkflowInvalidParameterErrorBuilder {
+	return &WorkflowInvalidParameterErrorBuilder{arguments: impl.ErrorArguments{"parameter": impl.NewErrorArgument(parameter, "User provided parameter")}}
+}
+
+// NewWorkflowInvalidParameterError creates a new error with the code "invalid_parameter_error".
+func NewWorkflowInvalidParameterError(parameter string) Error {
+	return NewWorkflowInvalidParameterErrorBuilder(parameter).Build()
+}
+
+// WorkflowInvalidRunNumberErrorCode is the code for an instance of "invalid_run_number_error".
+const WorkflowInvalidRunNumberErrorCode = "rcli_workflow_invalid_run_number_error"
+
+// IsWorkflowInvalidRunNumberError tests whether a given error is an instance of "invalid_run_number_error".
+func IsWorkflowInvalidRunNumberError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowInvalidRunNumberErrorCode)
+}
+
+// IsWorkflowInvalidRunNumberError tests whether a given error is an instance of "invalid_run_number_error".
+func (External) IsWorkflowInvalidRunNumberError(err errawr.Error) bool {
+	return IsWorkflowInvalidRunNumberError(err)
+}
+
+// WorkflowInvalidRunNumberErrorBuilder is a builder for "invalid_run_number_error" errors.
+type WorkflowInvalidRunNumberErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "invalid_run_number_error" from this builder.
+func (b *WorkflowInvalidRunNumberErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "'{{ run }}' is not a valid run number. Run numbers are positive integers.",
+		Technical: "'{{ run }}' is not a valid run number. Run numbers are positive integers.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "invalid_run_number_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Invalid run number",
+		Version:          1,
+	}
+}
+
+// NewWorkflowInvalidRunNumberErrorBuilder creates a new error builder for the code "invalid_run_number_error".
+func NewWorkflowInvalidRunNumberErrorBuilder(run string) *WorkflowInvalidRunNumberErrorBuilder {
+	return &WorkflowInvalidRunNumberErrorBuilder{arguments: impl.ErrorArguments{"run": impl.NewErrorArgument(run, "User provided run number")}}
+}
+
+// NewWorkflowInvalidRunNumberError creates a new error with the code "invalid_run_number_error".
+func NewWorkflowInvalidRunNumberError(run string) Error {
+	return NewWorkflowInvalidRunNumberErrorBuilder(run).Build()
+}
+
+// WorkflowInvalidScheduleCountErrorCode is the code for an instance of "invalid_schedule_count_error".
+const WorkflowInvalidScheduleCountErrorCode = "rcli_workflow_invalid_schedule_count_error"
+
+// IsWorkflowInvalidScheduleCountError tests whether a given error is an instance of "invalid_schedule_count_error".
+func IsWorkflowInvalidScheduleCountError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowInvalidScheduleCountErrorCode)
+}
+
+// IsWorkflowInvalidScheduleCountError tests whether a given error is an instance of "invalid_schedule_count_error".
+func (External) IsWorkflowInvalidScheduleCountError(err errawr.Error) bool {
+	return IsWorkflowInvalidScheduleCountError(err)
+}
+
+// WorkflowInvalidScheduleCountErrorBuilder is a builder for "invalid_schedule_count_error" errors.
+type WorkflowInvalidScheduleCountErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "invalid_schedule_count_error" from this builder.
+func (b *WorkflowInvalidScheduleCountErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "'{{ count }}' is not a valid number of fire times to list. It must be a positive integer.",
+		Technical: "'{{ count }}' is not a valid number of fire times to list. It must be a positive integer.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "invalid_schedule_count_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Invalid schedule count",
+		Version:          1,
+	}
+}
+
+// NewWorkflowInvalidScheduleCountErrorBuilder creates a new error builder for the code "invalid_schedule_count_error".
+func NewWorkflowInvalidScheduleCountErrorBuilder(count string) *WorkflowInvalidScheduleCountErrorBuilder {
+	return &WorkflowInvalidScheduleCountErrorBuilder{arguments: impl.ErrorArguments{"count": impl.NewErrorArgument(count, "User provided number of fire times")}}
+}
+
+// NewWorkflowInvalidScheduleCountError creates a new error with the code "invalid_schedule_count_error".
+func NewWorkflowInvalidScheduleCountError(count string) Error {
+	return NewWorkflowInvalidScheduleCountErrorBuilder(count).Build()
+}
+
+// WorkflowInvalidScheduleErrorCode is the code for an instance of "invalid_schedule_error".
+const WorkflowInvalidScheduleErrorCode = "rcli_workflow_invalid_schedule_error"
+
+// IsWorkflowInvalidScheduleError tests whether a given error is an instance of "invalid_schedule_error".
+func IsWorkflowInvalidScheduleError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowInvalidScheduleErrorCode)
+}
+
+// IsWorkflowInvalidScheduleError tests whether a given error is an instance of "invalid_schedule_error".
+func (External) IsWorkflowInvalidScheduleError(err errawr.Error) bool {
+	return IsWorkflowInvalidScheduleError(err)
+}
+
+// WorkflowInvalidScheduleErrorBuilder is a builder for "invalid_schedule_error" errors.
+type WorkflowInvalidScheduleErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "invalid_schedule_error" from this builder.
+func (b *WorkflowInvalidScheduleErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Found {{ count }} schedule triggers with invalid cron expressions.",
+		Technical: "Found {{ count }} schedule triggers with invalid cron expressions.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "invalid_schedule_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Invalid schedule",
+		Version:          1,
+	}
+}
+
+// NewWorkflowInvalidScheduleErrorBuilder creates a new error builder for the code "invalid_schedule_error".
+func NewWorkflowInvalidScheduleErrorBuilder(count string) *WorkflowInvalidScheduleErrorBuilder {
+	return &WorkflowInvalidScheduleErrorBuilder{arguments: impl.ErrorArguments{"count": impl.NewErrorArgument(count, "The number of invalid schedules")}}
+}
+
+// NewWorkflowInvalidScheduleError creates a new error with the code "invalid_schedule_error".
+func NewWorkflowInvalidScheduleError(count string) Error {
+	return NewWorkflowInvalidScheduleErrorBuilder(count).Build()
+}
+
+// WorkflowInvalidStepsBackErrorCode is the code for an instance of "invalid_steps_back_error".
+const WorkflowInvalidStepsBackErrorCode = "rcli_workflow_invalid_steps_back_error"
+
+// IsWorkflowInvalidStepsBackError tests whether a given error is an instance of "invalid_steps_back_error".
+func IsWorkflowInvalidStepsBackError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowInvalidStepsBackErrorCode)
+}
+
+// IsWorkflowInvalidStepsBackError tests whether a given error is an instance of "invalid_steps_back_error".
+func (External) IsWorkflowInvalidStepsBackError(err errawr.Error) bool {
+	return IsWorkflowInvalidStepsBackError(err)
+}
+
+// WorkflowInvalidStepsBackErrorBuilder is a builder for "invalid_steps_back_error" errors.
+type WorkflowInvalidStepsBackErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "invalid_steps_back_error" from this builder.
+func (b *WorkflowInvalidStepsBackErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "'{{ steps }}' is not a valid number of revisions to go back. It must be a positive integer.",
+		Technical: "'{{ steps }}' is not a valid number of revisions to go back. It must be a positive integer.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "invalid_steps_back_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Invalid steps back",
+		Version:          1,
+	}
+}
+
+// NewWorkflowInvalidStepsBackErrorBuilder creates a new error builder for the code "invalid_steps_back_error".
+func NewWorkflowInvalidStepsBackErrorBuilder(steps string) *WorkflowInvalidStepsBackErrorBuilder {
+	return &WorkflowInvalidStepsBackErrorBuilder{arguments: impl.ErrorArguments{"steps": impl.NewErrorArgument(steps, "User provided number of revisions")}}
+}
+
+// NewWorkflowInvalidStepsBackError creates a new error with the code "invalid_steps_back_error".
+func NewWorkflowInvalidStepsBackError(steps string) Error {
+	return NewWorkflowInvalidStepsBackErrorBuilder(steps).Build()
+}
+
+// WorkflowInvalidTimezoneErrorCode is the code for an instance of "invalid_timezone_error".
+const WorkflowInvalidTimezoneErrorCode = "rcli_workflow_invalid_timezone_error"
+
+// IsWorkflowInvalidTimezoneError tests whether a given error is an instance of "invalid_timezone_error".
+func IsWorkflowInvalidTimezoneError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowInvalidTimezoneErrorCode)
+}
+
+// IsWorkflowInvalidTimezoneError tests whether a given error is an instance of "invalid_timezone_error".
+func (External) IsWorkflowInvalidTimezoneError(err errawr.Error) bool {
+	return IsWorkflowInvalidTimezoneError(err)
+}
+
+// WorkflowInvalidTimezoneErrorBuilder is a builder for "invalid_timezone_error" errors.
+type WorkflowInvalidTimezoneErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "invalid_timezone_error" from this builder.
+func (b *WorkflowInvalidTimezoneErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Unknown timezone {{ timezone }}. Use an IANA timezone name such as UTC or Europe/Berlin.",
+		Technical: "Unknown timezone {{ timezone }}. Use an IANA timezone name such as UTC or Europe/Berlin.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "invalid_timezone_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Invalid timezone",
+		Version:          1,
+	}
+}
+
+// NewWorkflowInvalidTimezoneErrorBuilder creates a new error builder for the code "invalid_timezone_error".
+func NewWorkflowInvalidTimezoneErrorBuilder(timezone string) *WorkflowInvalidTimezoneErrorBuilder {
+	return &WorkflowInvalidTimezoneErrorBuilder{arguments: impl.ErrorArguments{"timezone": impl.NewErrorArgument(timezone, "User provided timezone")}}
+}
+
+// NewWorkflowInvalidTimezoneError creates a new error with the code "invalid_timezone_error".
+func NewWorkflowInvalidTimezoneError(timezone string) Error {
+	return NewWorkflowInvalidTimezoneErrorBuilder(timezone).Build()
+}
+
+// WorkflowLintFailedErrorCode is the code for an instance of "lint_failed_error".
+const WorkflowLintFailedErrorCode = "rcli_workflow_lint_failed_error"
+
+// IsWorkflowLintFailedError tests whether a given error is an instance of "lint_failed_error".
+func IsWorkflowLintFailedError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowLintFailedErrorCode)
+}
+
+// IsWorkflowLintFailedError tests whether a given error is an instance of "lint_failed_error".
+func (External) IsWorkflowLintFailedError(err errawr.Error) bool {
+	return IsWorkflowLintFailedError(err)
+}
+
+// WorkflowLintFailedErrorBuilder is a builder for "lint_failed_error" errors.
+type WorkflowLintFailedErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "lint_failed_error" from this builder.
+func (b *WorkflowLintFailedErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Found {{ count }} errors in workflow files.",
+		Technical: "Found {{ count }} errors in workflow files.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "lint_failed_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Workflow lint failed",
+		Version:          1,
+	}
+}
+
+// NewWorkflowLintFailedErrorBuilder creates a new error builder for the code "lint_failed_error".
+func NewWorkflowLintFailedErrorBuilder(count string) *WorkflowLintFailedErrorBuilder {
+	return &WorkflowLintFailedErrorBuilder{arguments: impl.ErrorArguments{"count": impl.NewErrorArgument(count, "The number of error findings")}}
+}
+
+// NewWorkflowLintFailedError creates a new error with the code "lint_failed_error".
+func NewWorkflowLintFailedError(count string) Error {
+	return NewWorkflowLintFailedErrorBuilder(count).Build()
+}
+
+// WorkflowMissingFileFlagErrorCode is the code for an instance of "missing_file_flag_error".
+const WorkflowMissingFileFlagErrorCode = "rcli_workflow_missing_file_flag_error"
+
+// IsWorkflowMissingFileFlagError tests whether a given error is an instance of "missing_file_flag_error".
+func IsWorkflowMissingFileFlagError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowMissingFileFlagErrorCode)
+}
+
+// IsWorkflowMissingFileFlagError tests whether a given error is an instance of "missing_file_flag_error".
+func (External) IsWorkflowMissingFileFlagError(err errawr.Error) bool {
+	return IsWorkflowMissingFileFlagError(err)
+}
+
+// WorkflowMissingFileFlagErrorBuilder is a builder for "missing_file_flag_error" errors.
+type WorkflowMissingFileFlagErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "missing_file_flag_error" from this builder.
+func (b *WorkflowMissingFileFlagErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "You must specify a workflow file with the --file flag.",
+		Technical: "You must specify a workflow file with the --file flag.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "missing_file_flag_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Missing file flag error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowMissingFileFlagErrorBuilder creates a new error builder for the code "missing_file_flag_error".
+func NewWorkflowMissingFileFlagErrorBuilder() *WorkflowMissingFileFlagErrorBuilder {
+	return &WorkflowMissingFileFlagErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewWorkflowMissingFileFlagError creates a new error with the code "missing_file_flag_error".
+func NewWorkflowMissingFileFlagError() Error {
+	return NewWorkflowMissingFileFlagErrorBuilder().Build()
+}
+
+// WorkflowMissingNameErrorCode is the code for an instance of "missing_name_error".
+const WorkflowMissingNameErrorCode = "rcli_workflow_missing_name_error"
+
+// IsWorkflowMissingNameError tests whether a given error is an instance of "missing_name_error".
+func IsWorkflowMissingNameError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowMissingNameErrorCode)
+}
+
+// IsWorkflowMissingNameError tests whether a given error is an instance of "missing_name_error".
+func (External) IsWorkflowMissingNameError(err errawr.Error) bool {
+	return IsWorkflowMissingNameError(err)
+}
+
+// WorkflowMissingNameErrorBuilder is a builder for "missing_name_error" errors.
+type WorkflowMissingNameErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "missing_name_error" from this builder.
+func (b *WorkflowMissingNameErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Please provide a workflow name.",
+		Technical: "Please provide a workflow name.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "missing_name_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Missing workflow name error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowMissingNameErrorBuilder creates a new error builder for the code "missing_name_error".
+func NewWorkflowMissingNameErrorBuilder() *WorkflowMissingNameErrorBuilder {
+	return &WorkflowMissingNameErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewWorkflowMissingNameError creates a new error with the code "missing_name_error".
+func NewWorkflowMissingNameError() Error {
+	return NewWorkflowMissingNameErrorBuilder().Build()
+}
+
+// WorkflowMissingParametersErrorCode is the code for an instance of "missing_parameters_error".
+const WorkflowMissingParametersErrorCode = "rcli_workflow_missing_parameters_error"
+
+// IsWorkflowMissingParametersError tests whether a given error is an instance of "missing_parameters_error".
+func IsWorkflowMissingParametersError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowMissingParametersErrorCode)
+}
+
+// IsWorkflowMissingParametersError tests whether a given error is an instance of "missing_parameters_error".
+func (External) IsWorkflowMissingParametersError(err errawr.Error) bool {
+	return IsWorkflowMissingParametersError(err)
+}
+
+// WorkflowMissingParametersErrorBuilder is a builder for "missing_parameters_error" errors.
+type WorkflowMissingParametersErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "missing_parameters_error" from this builder.
+func (b *WorkflowMissingParametersErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "The workflow requires values for these parameters: {{ parameters }}.",
+		Technical: "The workflow requires values for these parameters: {{ parameters }}.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "missing_parameters_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Missing parameters",
+		Version:          1,
+	}
+}
+
+// NewWorkflowMissingParametersErrorBuilder creates a new error builder for the code "missing_parameters_error".
+func NewWorkflowMissingParametersErrorBuilder(parameters string) *WorkflowMissingParametersErrorBuilder {
+	return &WorkflowMissingParametersErrorBuilder{arguments: impl.ErrorArguments{"parameters": impl.NewErrorArgument(parameters, "Comma-separated list of required parameter names")}}
+}
+
+// NewWorkflowMissingParametersError creates a new error with the code "missing_parameters_error".
+func NewWorkflowMissingParametersError(parameters string) Error {
+	return NewWorkflowMissingParametersErrorBuilder(parameters).Build()
+}
+
+// WorkflowMissingRevisionIDErrorCode is the code for an instance of "missing_revision_id_error".
+const WorkflowMissingRevisionIDErrorCode = "rcli_workflow_missing_revision_id_error"
+
+// IsWorkflowMissingRevisionIDError tests whether a given error is an instance of "missing_revision_id_error".
+func IsWorkflowMissingRevisionIDError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowMissingRevisionIDErrorCode)
+}
+
+// IsWorkflowMissingRevisionIDError tests whether a given error is an instance of "missing_revision_id_error".
+func (External) IsWorkflowMissingRevisionIDError(err errawr.Error) bool {
+	return IsWorkflowMissingRevisionIDError(err)
+}
+
+// WorkflowMissingRevisionIDErrorBuilder is a builder for "missing_revision_id_error" errors.
+type WorkflowMissingRevisionIDErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "missing_revision_id_error" from this builder.
+func (b *WorkflowMissingRevisionIDErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Please provide a revision ID.",
+		Technical: "Please provide a revision ID.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "missing_revision_id_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Missing revision ID error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowMissingRevisionIDErrorBuilder creates a new error builder for the code "missing_revision_id_error".
+func NewWorkflowMissingRevisionIDErrorBuilder() *WorkflowMissingRevisionIDErrorBuilder {
+	return &WorkflowMissingRevisionIDErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewWorkflowMissingRevisionIDError creates a new error with the code "missing_revision_id_error".
+func NewWorkflowMissingRevisionIDError() Error {
+	return NewWorkflowMissingRevisionIDErrorBuilder().Build()
+}
+
+// WorkflowMissingStepNameErrorCode is the code for an instance of "missing_step_name_error".
+const WorkflowMissingStepNameErrorCode = "rcli_workflow_missing_step_name_error"
+
+// IsWorkflowMissingStepNameError tests whether a given error is an instance of "missing_step_name_error".
+func IsWorkflowMissingStepNameError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowMissingStepNameErrorCode)
+}
+
+// IsWorkflowMissingStepNameError tests whether a given error is an instance of "missing_step_name_error".
+func (External) IsWorkflowMissingStepNameError(err errawr.Error) bool {
+	return IsWorkflowMissingStepNameError(err)
+}
+
+// WorkflowMissingStepNameErrorBuilder is a builder for "missing_step_name_error" errors.
+type WorkflowMissingStepNameErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "missing_step_name_error" from this builder.
+func (b *WorkflowMissingStepNameErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Please provide a step name.",
+		Technical: "Please provide a step name.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "missing_step_name_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Missing step name error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowMissingStepNameErrorBuilder creates a new error builder for the code "missing_step_name_error".
+func NewWorkflowMissingStepNameErrorBuilder() *WorkflowMissingStepNameErrorBuilder {
+	return &WorkflowMissingStepNameErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewWorkflowMissingStepNameError creates a new error with the code "missing_step_name_error".
+func NewWorkflowMissingStepNameError() Error {
+	return NewWorkflowMissingStepNameErrorBuilder().Build()
+}
+
+// WorkflowMultipleTriggersErrorCode is the code for an instance of "multiple_triggers_error".
+const WorkflowMultipleTriggersErrorCode = "rcli_workflow_multiple_triggers_error"
+
+// IsWorkflowMultipleTriggersError tests whether a given error is an instance of "multiple_triggers_error".
+func IsWorkflowMultipleTriggersError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowMultipleTriggersErrorCode)
+}
+
+// IsWorkflowMultipleTriggersError tests whether a given error is an instance of "multiple_triggers_error".
+func (External) IsWorkflowMultipleTriggersError(err errawr.Error) bool {
+	return IsWorkflowMultipleTriggersError(err)
+}
+
+// WorkflowMultipleTriggersErrorBuilder is a builder for "multiple_triggers_error" errors.
+type WorkflowMultipleTriggersErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "multiple_triggers_error" from this builder.
+func (b *WorkflowMultipleTriggersErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Workflow {{ workflow }} has more than one {{ type }} trigger. Choose one with --trigger: {{ triggers }}.",
+		Technical: "Workflow {{ workflow }} has more than one {{ type }} trigger. Choose one with --trigger: {{ triggers }}.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "multiple_triggers_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Multiple triggers of type",
+		Version:          1,
+	}
+}
+
+// NewWorkflowMultipleTriggersErrorBuilder creates a new error builder for the code "multiple_triggers_error".
+func NewWorkflowMultipleTriggersErrorBuilder(workflow string, type_ string, triggers string) *WorkflowMultipleTriggersErrorBuilder {
+	return &WorkflowMultipleTriggersErrorBuilder{arguments: impl.ErrorArguments{
+		"triggers": impl.NewErrorArgument(triggers, "The names of the triggers"),
+		"type":     impl.NewErrorArgument(type_, "The trigger type"),
+		"workflow": impl.NewErrorArgument(workflow, "The workflow name"),
+	}}
+}
+
+// NewWorkflowMultipleTriggersError creates a new error with the code "multiple_triggers_error".
+func NewWorkflowMultipleTriggersError(workflow string, type_ string, triggers string) Error {
+	return NewWorkflowMultipleTriggersErrorBuilder(workflow, type_, triggers).Build()
+}
+
+// WorkflowNoTriggersErrorCode is the code for an instance of "no_triggers_error".
+const WorkflowNoTriggersErrorCode = "rcli_workflow_no_triggers_error"
+
+// IsWorkflowNoTriggersError tests whether a given error is an instance of "no_triggers_error".
+func IsWorkflowNoTriggersError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowNoTriggersErrorCode)
+}
+
+// IsWorkflowNoTriggersError tests whether a given error is an instance of "no_triggers_error".
+func (External) IsWorkflowNoTriggersError(err errawr.Error) bool {
+	return IsWorkflowNoTriggersError(err)
+}
+
+// WorkflowNoTriggersErrorBuilder is a builder for "no_triggers_error" errors.
+type WorkflowNoTriggersErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "no_triggers_error" from this builder.
+func (b *WorkflowNoTriggersErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Workflow {{ workflow }} has no {{ type }} triggers.",
+		Technical: "Workflow {{ workflow }} has no {{ type }} triggers.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "no_triggers_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "No triggers of type",
+		Version:          1,
+	}
+}
+
+// NewWorkflowNoTriggersErrorBuilder creates a new error builder for the code "no_triggers_error".
+func NewWorkflowNoTriggersErrorBuilder(workflow string, type_ string) *WorkflowNoTriggersErrorBuilder {
+	return &WorkflowNoTriggersErrorBuilder{arguments: impl.ErrorArguments{
+		"type":     impl.NewErrorArgument(type_, "The trigger type"),
+		"workflow": impl.NewErrorArgument(workflow, "The workflow name"),
+	}}
+}
+
+// NewWorkflowNoTriggersError creates a new error with the code "no_triggers_error".
+func NewWorkflowNoTriggersError(workflow string, type_ string) Error {
+	return NewWorkflowNoTriggersErrorBuilder(workflow, type_).Build()
+}
+
+// WorkflowParametersFileDecodeErrorCode is the code for an instance of "parameters_file_decode_error".
+const WorkflowParametersFileDecodeErrorCode = "rcli_workflow_parameters_file_decode_error"
+
+// IsWorkflowParametersFileDecodeError tests whether a given error is an instance of "parameters_file_decode_error".
+func IsWorkflowParametersFileDecodeError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowParametersFileDecodeErrorCode)
+}
+
+// IsWorkflowParametersFileDecodeError tests whether a given error is an instance of "parameters_file_decode_error".
+func (External) IsWorkflowParametersFileDecodeError(err errawr.Error) bool {
+	return IsWorkflowParametersFileDecodeError(err)
+}
+
+// WorkflowParametersFileDecodeErrorBuilder is a builder for "parameters_file_decode_error" errors.
+type WorkflowParametersFileDecodeErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "parameters_file_decode_error" from this builder.
+func (b *WorkflowParametersFileDecodeErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not parse parameters file {{ path }}. The file must contain a YAML or JSON object mapping parameter names to values.",
+		Technical: "Could not parse parameters file {{ path }}. The file must contain a YAML or JSON object mapping parameter names to values.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "parameters_file_decode_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Parameters file decode error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowParametersFileDecodeErrorBuilder creates a new error builder for the code "parameters_file_decode_error".
+func NewWorkflowParametersFileDecodeErrorBuilder(path string) *WorkflowParametersFileDecodeErrorBuilder {
+	return &WorkflowParametersFileDecodeErrorBuilder{arguments: impl.ErrorArguments{"path": impl.NewErrorArgument(path, "User provided parameters file path")}}
+}
+
+// NewWorkflowParametersFileDecodeError creates a new error with the code "parameters_file_decode_error".
+func NewWorkflowParametersFileDecodeError(path string) Error {
+	return NewWorkflowParametersFileDecodeErrorBuilder(path).Build()
+}
+
+// WorkflowParametersFileReadErrorCode is the code for an instance of "parameters_file_read_error".
+const WorkflowParametersFileReadErrorCode = "rcli_workflow_parameters_file_read_error"
+
+// IsWorkflowParametersFileReadError tests whether a given error is an instance of "parameters_file_read_error".
+func IsWorkflowParametersFileReadError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowParametersFileReadErrorCode)
+}
+
+// IsWorkflowParametersFileReadError tests whether a given error is an instance of "parameters_file_read_error".
+func (External) IsWorkflowParametersFileReadError(err errawr.Error) bool {
+	return IsWorkflowParametersFileReadError(err)
+}
+
+// WorkflowParametersFileReadErrorBuilder is a builder for "parameters_file_read_error" errors.
+type WorkflowParametersFileReadErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "parameters_file_read_error" from this builder.
+func (b *WorkflowParametersFileReadErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not read parameters file {{ path }}. Check the path to the parameters file.",
+		Technical: "Could not read parameters file {{ path }}. Check the path to the parameters file.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "parameters_file_read_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Parameters file read error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowParametersFileReadErrorBuilder creates a new error builder for the code "parameters_file_read_error".
+func NewWorkflowParametersFileReadErrorBuilder(path string) *WorkflowParametersFileReadErrorBuilder {
+	return &WorkflowParametersFileReadErrorBuilder{arguments: impl.ErrorArguments{"path": impl.NewErrorArgument(path, "User provided parameters file path")}}
+}
+
+// NewWorkflowParametersFileReadError creates a new error with the code "parameters_file_read_error".
+func NewWorkflowParametersFileReadError(path string) Error {
+	return NewWorkflowParametersFileReadErrorBuilder(path).Build()
+}
+
+// WorkflowPushDataDecodeErrorCode is the code for an instance of "push_data_decode_error".
+const WorkflowPushDataDecodeErrorCode = "rcli_workflow_push_data_decode_error"
+
+// IsWorkflowPushDataDecodeError tests whether a given error is an instance of "push_data_decode_error".
+func IsWorkflowPushDataDecodeError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowPushDataDecodeErrorCode)
+}
+
+// IsWorkflowPushDataDecodeError tests whether a given error is an instance of "push_data_decode_error".
+func (External) IsWorkflowPushDataDecodeError(err errawr.Error) bool {
+	return IsWorkflowPushDataDecodeError(err)
+}
+
+// WorkflowPushDataDecodeErrorBuilder is a builder for "push_data_decode_error" errors.
+type WorkflowPushDataDecodeErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "push_data_decode_error" from this builder.
+func (b *WorkflowPushDataDecodeErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Event data must be a JSON object.",
+		Technical: "Event data must be a JSON object.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "push_data_decode_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Event data decode error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowPushDataDecodeErrorBuilder creates a new error builder for the code "push_data_decode_error".
+func NewWorkflowPushDataDecodeErrorBuilder() *WorkflowPushDataDecodeErrorBuilder {
+	return &WorkflowPushDataDecodeErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewWorkflowPushDataDecodeError creates a new error with the code "push_data_decode_error".
+func NewWorkflowPushDataDecodeError() Error {
+	return NewWorkflowPushDataDecodeErrorBuilder().Build()
+}
+
+// WorkflowPushDataInvalidErrorCode is the code for an instance of "push_data_invalid_error".
+const WorkflowPushDataInvalidErrorCode = "rcli_workflow_push_data_invalid_error"
+
+// IsWorkflowPushDataInvalidError tests whether a given error is an instance of "push_data_invalid_error".
+func IsWorkflowPushDataInvalidError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowPushDataInvalidErrorCode)
+}
+
+// IsWorkflowPushDataInvalidError tests whether a given error is an instance of "push_data_invalid_error".
+func (External) IsWorkflowPushDataInvalidError(err errawr.Error) bool {
+	return IsWorkflowPushDataInvalidError(err)
+}
+
+// WorkflowPushDataInvalidErrorBuilder is a builder for "push_data_invalid_error" errors.
+type WorkflowPushDataInvalidErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "push_data_invalid_error" from this builder.
+func (b *WorkflowPushDataInvalidErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Event data does not match the schema of trigger {{ trigger }}: {{ problems }}",
+		Technical: "Event data does not match the schema of trigger {{ trigger }}: {{ problems }}",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "push_data_invalid_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Invalid event data",
+		Version:          1,
+	}
+}
+
+// NewWorkflowPushDataInvalidErrorBuilder creates a new error builder for the code "push_data_invalid_error".
+func NewWorkflowPushDataInvalidErrorBuilder(trigger string, problems string) *WorkflowPushDataInvalidErrorBuilder {
+	return &WorkflowPushDataInvalidErrorBuilder{arguments: impl.ErrorArguments{
+		"problems": impl.NewErrorArgument(problems, "The schema violations found"),
+		"trigger":  impl.NewErrorArgument(trigger, "The trigger name"),
+	}}
+}
+
+// NewWorkflowPushDataInvalidError creates a new error with the code "push_data_invalid_error".
+func NewWorkflowPushDataInvalidError(trigger string, problems string) Error {
+	return NewWorkflowPushDataInvalidErrorBuilder(trigger, problems).Build()
+}
+
+// WorkflowPushDataReadErrorCode is the code for an instance of "push_data_read_error".
+const WorkflowPushDataReadErrorCode = "rcli_workflow_push_data_read_error"
+
+// IsWorkflowPushDataReadError tests whether a given error is an instance of "push_data_read_error".
+func IsWorkflowPushDataReadError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowPushDataReadErrorCode)
+}
+
+// IsWorkflowPushDataReadError tests whether a given error is an instance of "push_data_read_error".
+func (External) IsWorkflowPushDataReadError(err errawr.Error) bool {
+	return IsWorkflowPushDataReadError(err)
+}
+
+// WorkflowPushDataReadErrorBuilder is a builder for "push_data_read_error" errors.
+type WorkflowPushDataReadErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "push_data_read_error" from this builder.
+func (b *WorkflowPushDataReadErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not read event data. Pass JSON to --data, or @path to read it from a file or @- to read it from stdin.",
+		Technical: "Could not read event data. Pass JSON to --data, or @path to read it from a file or @- to read it from stdin.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "push_data_read_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Event data read error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowPushDataReadErrorBuilder creates a new error builder for the code "push_data_read_error".
+func NewWorkflowPushDataReadErrorBuilder() *WorkflowPushDataReadErrorBuilder {
+	return &WorkflowPushDataReadErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewWorkflowPushDataReadError creates a new error with the code "push_data_read_error".
+func NewWorkflowPushDataReadError() Error {
+	return NewWorkflowPushDataReadErrorBuilder().Build()
+}
+
+// WorkflowPushTriggerTokenUnavailableErrorCode is the code for an instance of "push_trigger_token_unavailable_error".
+const WorkflowPushTriggerTokenUnavailableErrorCode = "rcli_workflow_push_trigger_token_unavailable_error"
+
+// IsWorkflowPushTriggerTokenUnavailableError tests whether a given error is an instance of "push_trigger_token_unavailable_error".
+func IsWorkflowPushTriggerTokenUnavailableError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowPushTriggerTokenUnavailableErrorCode)
+}
+
+// IsWorkflowPushTriggerTokenUnavailableError tests whether a given error is an instance of "push_trigger_token_unavailable_error".
+func (External) IsWorkflowPushTriggerTokenUnavailableError(err errawr.Error) bool {
+	return IsWorkflowPushTriggerTokenUnavailableError(err)
+}
+
+// WorkflowPushTriggerTokenUnavailableErrorBuilder is a builder for "push_trigger_token_unavailable_error" errors.
+type WorkflowPushTriggerTokenUnavailableErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "push_trigger_token_unavailable_error" from this builder.
+func (b *WorkflowPushTriggerTokenUnavailableErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "The push token for trigger {{ trigger }} is not available yet. Try again once the trigger is ready.",
+		Technical: "The push token for trigger {{ trigger }} is not available yet. Try again once the trigger is ready.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "push_trigger_token_unavailable_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Push trigger token unavailable",
+		Version:          1,
+	}
+}
+
+// NewWorkflowPushTriggerTokenUnavailableErrorBuilder creates a new error builder for the code "push_trigger_token_unavailable_error".
+func NewWorkflowPushTriggerTokenUnavailableErrorBuilder(trigger string) *WorkflowPushTriggerTokenUnavailableErrorBuilder {
+	return &WorkflowPushTriggerTokenUnavailableErrorBuilder{arguments: impl.ErrorArguments{"trigger": impl.NewErrorArgument(trigger, "The trigger name")}}
+}
+
+// NewWorkflowPushTriggerTokenUnavailableError creates a new error with the code "push_trigger_token_unavailable_error".
+func NewWorkflowPushTriggerTokenUnavailableError(trigger string) Error {
+	return NewWorkflowPushTriggerTokenUnavailableErrorBuilder(trigger).Build()
+}
+
+// WorkflowRevisionIDReadErrorCode is the code for an instance of "revision_id_read_error".
+const WorkflowRevisionIDReadErrorCode = "rcli_workflow_revision_id_read_error"
+
+// IsWorkflowRevisionIDReadError tests whether a given error is an instance of "revision_id_read_error".
+func IsWorkflowRevisionIDReadError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowRevisionIDReadErrorCode)
+}
+
+// IsWorkflowRevisionIDReadError tests whether a given error is an instance of "revision_id_read_error".
+func (External) IsWorkflowRevisionIDReadError(err errawr.Error) bool {
+	return IsWorkflowRevisionIDReadError(err)
+}
+
+// WorkflowRevisionIDReadErrorBuilder is a builder for "revision_id_read_error" errors.
+type WorkflowRevisionIDReadErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "revision_id_read_error" from this builder.
+func (b *WorkflowRevisionIDReadErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not read revision ID. Please supply a valid revision ID.",
+		Technical: "Could not read revision ID. Please supply a valid revision ID.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "revision_id_read_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Revision ID read error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowRevisionIDReadErrorBuilder creates a new error builder for the code "revision_id_read_error".
+func NewWorkflowRevisionIDReadErrorBuilder() *WorkflowRevisionIDReadErrorBuilder {
+	return &WorkflowRevisionIDReadErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewWorkflowRevisionIDReadError creates a new error with the code "revision_id_read_error".
+func NewWorkflowRevisionIDReadError() Error {
+	return NewWorkflowRevisionIDReadErrorBuilder().Build()
+}
+
+// WorkflowRollbackRevisionNotFoundErrorCode is the code for an instance of "rollback_revision_not_found_error".
+const WorkflowRollbackRevisionNotFoundErrorCode = "rcli_workflow_rollback_revision_not_found_error"
+
+// IsWorkflowRollbackRevisionNotFoundError tests whether a given error is an instance of "rollback_revision_not_found_error".
+func IsWorkflowRollbackRevisionNotFoundError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowRollbackRevisionNotFoundErrorCode)
+}
+
+// IsWorkflowRollbackRevisionNotFoundError tests whether a given error is an instance of "rollback_revision_not_found_error".
+func (External) IsWorkflowRollbackRevisionNotFoundError(err errawr.Error) bool {
+	return IsWorkflowRollbackRevisionNotFoundError(err)
+}
+
+// WorkflowRollbackRevisionNotFoundErrorBuilder is a builder for "rollback_revision_not_found_error" errors.
+type WorkflowRollbackRevisionNotFoundErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "rollback_revision_not_found_error" from this builder.
+func (b *WorkflowRollbackRevisionNotFoundErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Cannot go back {{ steps }} revisions; only {{ available }} earlier revisions of this workflow are known. Use --to to choose a revision by ID.",
+		Technical: "Cannot go back {{ steps }} revisions; only {{ available }} earlier revisions of this workflow are known. Use --to to choose a revision by ID.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "rollback_revision_not_found_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Rollback revision not found",
+		Version:          1,
+	}
+}
+
+// NewWorkflowRollbackRevisionNotFoundErrorBuilder creates a new error builder for the code "rollback_revision_not_found_error".
+func NewWorkflowRollbackRevisionNotFoundErrorBuilder(steps string, available string) *WorkflowRollbackRevisionNotFoundErrorBuilder {
+	return &WorkflowRollbackRevisionNotFoundErrorBuilder{arguments: impl.ErrorArguments{
+		"available": impl.NewErrorArgument(available, "The number of earlier revisions found"),
+		"steps":     impl.NewErrorArgument(steps, "User provided number of revisions"),
+	}}
+}
+
+// NewWorkflowRollbackRevisionNotFoundError creates a new error with the code "rollback_revision_not_found_error".
+func NewWorkflowRollbackRevisionNotFoundError(steps string, available string) Error {
+	return NewWorkflowRollbackRevisionNotFoundErrorBuilder(steps, available).Build()
+}
+
+// WorkflowRunFailedErrorCode is the code for an instance of "run_failed_error".
+const WorkflowRunFailedErrorCode = "rcli_workflow_run_failed_error"
+
+// IsWorkflowRunFailedError tests whether a given error is an instance of "run_failed_error".
+func IsWorkflowRunFailedError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowRunFailedErrorCode)
+}
+
+// IsWorkflowRunFailedError tests whether a given error is an instance of "run_failed_error".
+func (External) IsWorkflowRunFailedError(err errawr.Error) bool {
+	return IsWorkflowRunFailedError(err)
+}
+
+// WorkflowRunFailedErrorBuilder is a builder for "run_failed_error" errors.
+type WorkflowRunFailedErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "run_failed_error" from this builder.
+func (b *WorkflowRunFailedErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Workflow run {{ run }} finished with status {{ status }}.",
+		Technical: "Workflow run {{ run }} finished with status {{ status }}.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "run_failed_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Workflow run failed",
+		Version:          1,
+	}
+}
+
+// NewWorkflowRunFailedErrorBuilder creates a new error builder for the code "run_failed_error".
+func NewWorkflowRunFailedErrorBuilder(run string, status string) *WorkflowRunFailedErrorBuilder {
+	return &WorkflowRunFailedErrorBuilder{arguments: impl.ErrorArguments{
+		"run":    impl.NewErrorArgument(run, "The workflow run number"),
+		"status": impl.NewErrorArgument(status, "The final status of the workflow run"),
+	}}
+}
+
+// NewWorkflowRunFailedError creates a new error with the code "run_failed_error".
+func NewWorkflowRunFailedError(run string, status string) Error {
+	return NewWorkflowRunFailedErrorBuilder(run, status).Build()
+}
+
+// WorkflowRunNumberReadErrorCode is the code for an instance of "run_number_read_error".
+const WorkflowRunNumberReadErrorCode = "rcli_workflow_run_number_read_error"
+
+// IsWorkflowRunNumberReadError tests whether a given error is an instance of "run_number_read_error".
+func IsWorkflowRunNumberReadError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowRunNumberReadErrorCode)
+}
+
+// IsWorkflowRunNumberReadError tests whether a given error is an instance of "run_number_read_error".
+func (External) IsWorkflowRunNumberReadError(err errawr.Error) bool {
+	return IsWorkflowRunNumberReadError(err)
+}
+
+// WorkflowRunNumberReadErrorBuilder is a builder for "run_number_read_error" errors.
+type WorkflowRunNumberReadErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "run_number_read_error" from this builder.
+func (b *WorkflowRunNumberReadErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not read run number. Please supply a valid run number.",
+		Technical: "Could not read run number. Please supply a valid run number.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "run_number_read_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Run number read error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowRunNumberReadErrorBuilder creates a new error builder for the code "run_number_read_error".
+func NewWorkflowRunNumberReadErrorBuilder() *WorkflowRunNumberReadErrorBuilder {
+	return &WorkflowRunNumberReadErrorBuilder{arguments: impl.ErrorArguments{}}
+}
+
+// NewWorkflowRunNumberReadError creates a new error with the code "run_number_read_error".
+func NewWorkflowRunNumberReadError() Error {
+	return NewWorkflowRunNumberReadErrorBuilder().Build()
+}
+
+// WorkflowRunWatchTimeoutErrorCode is the code for an instance of "run_watch_timeout_error".
+const WorkflowRunWatchTimeoutErrorCode = "rcli_workflow_run_watch_timeout_error"
+
+// IsWorkflowRunWatchTimeoutError tests whether a given error is an instance of "run_watch_timeout_error".
+func IsWorkflowRunWatchTimeoutError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowRunWatchTimeoutErrorCode)
+}
+
+// IsWorkflowRunWatchTimeoutError tests whether a given error is an instance of "run_watch_timeout_error".
+func (External) IsWorkflowRunWatchTimeoutError(err errawr.Error) bool {
+	return IsWorkflowRunWatchTimeoutError(err)
+}
+
+// WorkflowRunWatchTimeoutErrorBuilder is a builder for "run_watch_timeout_error" errors.
+type WorkflowRunWatchTimeoutErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "run_watch_timeout_error" from this builder.
+func (b *WorkflowRunWatchTimeoutErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Timed out after {{ timeout }} waiting for workflow run {{ run }} to finish.",
+		Technical: "Timed out after {{ timeout }} waiting for workflow run {{ run }} to finish.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "run_watch_timeout_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Workflow run watch timeout",
+		Version:          1,
+	}
+}
+
+// NewWorkflowRunWatchTimeoutErrorBuilder creates a new error builder for the code "run_watch_timeout_error".
+func NewWorkflowRunWatchTimeoutErrorBuilder(run string, timeout string) *WorkflowRunWatchTimeoutErrorBuilder {
+	return &WorkflowRunWatchTimeoutErrorBuilder{arguments: impl.ErrorArguments{
+		"run":     impl.NewErrorArgument(run, "The workflow run number"),
+		"timeout": impl.NewErrorArgument(timeout, "The amount of time spent waiting for the run"),
+	}}
+}
+
+// NewWorkflowRunWatchTimeoutError creates a new error with the code "run_watch_timeout_error".
+func NewWorkflowRunWatchTimeoutError(run string, timeout string) Error {
+	return NewWorkflowRunWatchTimeoutErrorBuilder(run, timeout).Build()
+}
+
+// WorkflowStepNameReadErrorCode is the code for an instance of "step_name_read_error".
+const WorkflowStepNameReadErrorCode = "rcli_workflow_step_name_read_error"
+
+// IsWorkflowStepNameReadError tests whether a given error is an instance of "step_name_read_error".
+func IsWorkflowStepNameReadError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowStepNameReadErrorCode)
+}
+
+// IsWorkflowStepNameReadError tests whether a given error is an instance of "step_name_read_error".
+func (External) IsWorkflowStepNameReadError(err errawr.Error) bool {
+	return IsWorkflowStepNameReadError(err)
+}
+
+// WorkflowStepNameReadErrorBuilder is a builder for "step_name_read_error" errors.
+type WorkflowStepNameReadErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "step_name_read_error" from this builder.
+func (b *WorkflowStepNameReadErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not read step name. Please supply a valid name.",
+		Technical: "Could not read step name. Please supply a valid name.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "step_name_read_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Step name read error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowStepNameReadErrorBuilder creates a new error builder for the code "step_name_read_error".
+func NewWorkflowStepNameReadErrorBuilder() *WorkflowStepNameReadErrorBuilder {
+	return &WorkflowStepNameReadErrorBuilder{arguments: impl.ErrorArguments{}}
 }
 
-// SecretNameReadErrorCode is the code for an instance of "name_read_error".
-const SecretNameReadErrorCode = "rcli_secret_name_read_error"
+// NewWorkflowStepNameReadError creates a new error with the code "step_name_read_error".
+func NewWorkflowStepNameReadError() Error {
+	return NewWorkflowStepNameReadErrorBuilder().Build()
+}
 
-// IsSecretNameReadError tests whether a given error is an instance of "name_read_error".
-func IsSecretNameReadError(err errawr.Error) bool {
-	return err != nil && err.Is(SecretNameReadErrorCode)
+// WorkflowTriggerNotFoundErrorCode is the code for an instance of "trigger_not_found_error".
+const WorkflowTriggerNotFoundErrorCode = "rcli_workflow_trigger_not_found_error"
+
+// IsWorkflowTriggerNotFoundError tests whether a given error is an instance of "trigger_not_found_error".
+func IsWorkflowTriggerNotFoundError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowTriggerNotFoundErrorCode)
 }
 
-// IsSecretNameReadError tests whether a given error is an instance of "name_read_error".
-func (External) IsSecretNameReadError(err errawr.Error) bool {
-	return IsSecretNameReadError(err)
+// IsWorkflowTriggerNotFoundError tests whether a given error is an instance of "trigger_not_found_error".
+func (External) IsWorkflowTriggerNotFoundError(err errawr.Error) bool {
+	return IsWorkflowTriggerNotFoundError(err)
 }
 
-// SecretNameReadErrorBuilder is a builder for "name_read_error" errors.
-type SecretNameReadErrorBuilder struct {
+// WorkflowTriggerNotFoundErrorBuilder is a builder for "trigger_not_found_error" errors.
+type WorkflowTriggerNotFoundErrorBuilder struct {
 	arguments impl.ErrorArguments
 }
 
-// Build creates the error for the code "name_read_error" from this builder.
-func (b *SecretNameReadErrorBuilder) Build() Error {
+// Build creates the error for the code "trigger_not_found_error" from this builder.
+func (b *WorkflowTriggerNotFoundErrorBuilder) Build() Error {
 	description := &impl.ErrorDescription{
-		Friendly:  "Could not read secret name. Please supply a valid name.",
-		Technical: "Could not read secret name. Please supply a valid name.",
+		Friendly:  "Workflow {{ workflow }} has no {{ type }} trigger named {{ trigger }}.",
+		Technical: "Workflow {{ workflow }} has no {{ type }} trigger named {{ trigger }}.",
 	}
 
 	return &impl.Error{
 		ErrorArguments:   b.arguments,
-		ErrorCode:        "name_read_error",
+		ErrorCode:        "trigger_not_found_error",
 		ErrorDescription: description,
 		ErrorDomain:      Domain,
 		ErrorMetadata:    &impl.ErrorMetadata{},
-		ErrorSection:     SecretSection,
+		ErrorSection:     WorkflowSection,
 		ErrorSensitivity: errawr.ErrorSensitivityNone,
-		ErrorTitle:       "Secret name read error",
+		ErrorTitle:       "Trigger not found",
 		Version:          1,
 	}
 }
 
-// NewSecretNameReadErrorBuilder creates a new error builder for the code "name_read_error".
-func NewSecretNameReadErrorBuilder() *SecretNameReadErrorBuilder {
-	return &SecretNameReadErrorBuilder{arguments: impl.ErrorArguments{}}
+// NewWorkflowTriggerNotFoundErrorBuilder creates a new error builder for the code "trigger_not_found_error".
+func NewWorkflowTriggerNotFoundErrorBuilder(workflow string, type_ string, trigger string) *WorkflowTriggerNotFoundErrorBuilder {
+	return &WorkflowTriggerNotFoundErrorBuilder{arguments: impl.ErrorArguments{
+		"trigger":  impl.NewErrorArgument(trigger, "User provided trigger name"),
+		"type":     impl.NewErrorArgument(type_, "The trigger type"),
+		"workflow": impl.NewErrorArgument(workflow, "The workflow name"),
+	}}
 }
 
-// NewSecretNameReadError creates a new error with the code "name_read_error".
-func NewSecretNameReadError() Error {
-	return NewSecretNameReadErrorBuilder().Build()
+// NewWorkflowTriggerNotFoundError creates a new error with the code "trigger_not_found_error".
+func NewWorkflowTriggerNotFoundError(workflow string, type_ string, trigger string) Error {
+	return NewWorkflowTriggerNotFoundErrorBuilder(workflow, type_, trigger).Build()
 }
 
-// WorkflowSection defines a section of errors with the following scope:
-// Workflow errors
-var WorkflowSection = &impl.ErrorSection{
-	Key:   "workflow",
-	Title: "Workflow errors",
+// WorkflowUnknownParametersErrorCode is the code for an instance of "unknown_parameters_error".
+const WorkflowUnknownParametersErrorCode = "rcli_workflow_unknown_parameters_error"
+
+// IsWorkflowUnknownParametersError tests whether a given error is an instance of "unknown_parameters_error".
+func IsWorkflowUnknownParametersError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowUnknownParametersErrorCode)
 }
 
-// WorkflowAlreadyExistsErrorCode is the code for an instance of "already_exists_error".
-const WorkflowAlreadyExistsErrorCode = "rcli_workflow_already_exists_error"
+// IsWorkflowUnknownParametersError tests whether a given error is an instance of "unknown_parameters_error".
+func (External) IsWorkflowUnknownParametersError(err errawr.Error) bool {
+	return IsWorkflowUnknownParametersError(err)
+}
 
-// IsWorkflowAlreadyExistsError tests whether a given error is an instance of "already_exists_error".
-func IsWorkflowAlreadyExistsError(err errawr.Error) bool {
-	return err != nil && err.Is(WorkflowAlreadyExistsErrorCode)
+// WorkflowUnknownParametersErrorBuilder is a builder for "unknown_parameters_error" errors.
+type WorkflowUnknownParametersErrorBuilder struct {
+	arguments impl.ErrorArguments
 }
 
-// IsWorkflowAlreadyExistsError tests whether a given error is an instance of "already_exists_error".
-func (External) IsWorkflowAlreadyExistsError(err errawr.Error) bool {
-	return IsWorkflowAlreadyExistsError(err)
+// Build creates the error for the code "unknown_parameters_error" from this builder.
+func (b *WorkflowUnknownParametersErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "The workflow does not declare these parameters: {{ parameters }}.",
+		Technical: "The workflow does not declare these parameters: {{ parameters }}.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "unknown_parameters_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Unknown parameters",
+		Version:          1,
+	}
 }
 
-// WorkflowAlreadyExistsErrorBuilder is a builder for "already_exists_error" errors.
-type WorkflowAlreadyExistsErrorBuilder struct {
+// NewWorkflowUnknownParametersErrorBuilder creates a new error builder for the code "unknown_parameters_error".
+func NewWorkflowUnknownParametersErrorBuilder(parameters string) *WorkflowUnknownParametersErrorBuilder {
+	return &WorkflowUnknownParametersErrorBuilder{arguments: impl.ErrorArguments{"parameters": impl.NewErrorArgument(parameters, "Comma-separated list of undeclared parameter names")}}
+}
+
+// NewWorkflowUnknownParametersError creates a new error with the code "unknown_parameters_error".
+func NewWorkflowUnknownParametersError(parameters string) Error {
+	return NewWorkflowUnknownParametersErrorBuilder(parameters).Build()
+}
+
+// WorkflowWebhookCaptureErrorCode is the code for an instance of "webhook_capture_error".
+const WorkflowWebhookCaptureErrorCode = "rcli_workflow_webhook_capture_error"
+
+// IsWorkflowWebhookCaptureError tests whether a given error is an instance of "webhook_capture_error".
+func IsWorkflowWebhookCaptureError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowWebhookCaptureErrorCode)
+}
+
+// IsWorkflowWebhookCaptureError tests whether a given error is an instance of "webhook_capture_error".
+func (External) IsWorkflowWebhookCaptureError(err errawr.Error) bool {
+	return IsWorkflowWebhookCaptureError(err)
+}
+
+// WorkflowWebhookCaptureErrorBuilder is a builder for "webhook_capture_error" errors.
+type WorkflowWebhookCaptureErrorBuilder struct {
 	arguments impl.ErrorArguments
 }
 
-// Build creates the error for the code "already_exists_error" from this builder.
-func (b *WorkflowAlreadyExistsErrorBuilder) Build() Error {
+// Build creates the error for the code "webhook_capture_error" from this builder.
+func (b *WorkflowWebhookCaptureErrorBuilder) Build() Error {
 	description := &impl.ErrorDescription{
-		Friendly:  "A workflow with the name provided already exists. Please provide a new name.",
-		Technical: "A workflow with the name provided already exists. Please provide a new name.",
+		Friendly:  "Could not capture webhook requests on {{ address }}.",
+		Technical: "Could not capture webhook requests on {{ address }}.",
 	}
 
 	return &impl.Error{
 		ErrorArguments:   b.arguments,
-		ErrorCode:        "already_exists_error",
+		ErrorCode:        "webhook_capture_error",
 		ErrorDescription: description,
 		ErrorDomain:      Domain,
 		ErrorMetadata:    &impl.ErrorMetadata{},
 		ErrorSection:     WorkflowSection,
 		ErrorSensitivity: errawr.ErrorSensitivityNone,
-		ErrorTitle:       "Workflow name already exists",
+		ErrorTitle:       "Webhook capture error",
 		Version:          1,
 	}
 }
 
-// NewWorkflowAlreadyExistsErrorBuilder creates a new error builder for the code "already_exists_error".
-func NewWorkflowAlreadyExistsErrorBuilder() *WorkflowAlreadyExistsErrorBuilder {
-	return &WorkflowAlreadyExistsErrorBuilder{arguments: impl.ErrorArguments{}}
+// NewWorkflowWebhookCaptureErrorBuilder creates a new error builder for the code "webhook_capture_error".
+func NewWorkflowWebhookCaptureErrorBuilder(address string) *WorkflowWebhookCaptureErrorBuilder {
+	return &WorkflowWebhookCaptureErrorBuilder{arguments: impl.ErrorArguments{"address": impl.NewErrorArgument(address, "The address requests were to be received on")}}
 }
 
-// NewWorkflowAlreadyExistsError creates a new error with the code "already_exists_error".
-func NewWorkflowAlreadyExistsError() Error {
-	return NewWorkflowAlreadyExistsErrorBuilder().Build()
+// NewWorkflowWebhookCaptureError creates a new error with the code "webhook_capture_error".
+func NewWorkflowWebhookCaptureError(address string) Error {
+	return NewWorkflowWebhookCaptureErrorBuilder(address).Build()
 }
 
-// WorkflowDoesNotExistErrorCode is the code for an instance of "does_not_exist_error".
-const WorkflowDoesNotExistErrorCode = "rcli_workflow_does_not_exist_error"
+// WorkflowWebhookDevTriggerErrorCode is the code for an instance of "webhook_dev_trigger_error".
+const WorkflowWebhookDevTriggerErrorCode = "rcli_workflow_webhook_dev_trigger_error"
 
-// IsWorkflowDoesNotExistError tests whether a given error is an instance of "does_not_exist_error".
-func IsWorkflowDoesNotExistError(err errawr.Error) bool {
-	return err != nil && err.Is(WorkflowDoesNotExistErrorCode)
+// IsWorkflowWebhookDevTriggerError tests whether a given error is an instance of "webhook_dev_trigger_error".
+func IsWorkflowWebhookDevTriggerError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowWebhookDevTriggerErrorCode)
 }
 
-// IsWorkflowDoesNotExistError tests whether a given error is an instance of "does_not_exist_error".
-func (External) IsWorkflowDoesNotExistError(err errawr.Error) bool {
-	return IsWorkflowDoesNotExistError(err)
+// IsWorkflowWebhookDevTriggerError tests whether a given error is an instance of "webhook_dev_trigger_error".
+func (External) IsWorkflowWebhookDevTriggerError(err errawr.Error) bool {
+	return IsWorkflowWebhookDevTriggerError(err)
 }
 
-// WorkflowDoesNotExistErrorBuilder is a builder for "does_not_exist_error" errors.
-type WorkflowDoesNotExistErrorBuilder struct {
+// WorkflowWebhookDevTriggerErrorBuilder is a builder for "webhook_dev_trigger_error" errors.
+type WorkflowWebhookDevTriggerErrorBuilder struct {
 	arguments impl.ErrorArguments
 }
 
-// Build creates the error for the code "does_not_exist_error" from this builder.
-func (b *WorkflowDoesNotExistErrorBuilder) Build() Error {
+// Build creates the error for the code "webhook_dev_trigger_error" from this builder.
+func (b *WorkflowWebhookDevTriggerErrorBuilder) Build() Error {
 	description := &impl.ErrorDescription{
-		Friendly:  "A workflow with the name provided does not exist. Please choose an existing workflow.",
-		Technical: "A workflow with the name provided does not exist. Please choose an existing workflow.",
+		Friendly:  "Could not connect to the webhook trigger on the dev cluster.",
+		Technical: "Could not connect to the webhook trigger on the dev cluster.",
 	}
 
 	return &impl.Error{
 		ErrorArguments:   b.arguments,
-		ErrorCode:        "does_not_exist_error",
+		ErrorCode:        "webhook_dev_trigger_error",
 		ErrorDescription: description,
 		ErrorDomain:      Domain,
 		ErrorMetadata:    &impl.ErrorMetadata{},
 		ErrorSection:     WorkflowSection,
 		ErrorSensitivity: errawr.ErrorSensitivityNone,
-		ErrorTitle:       "Workflow name does not exist",
+		ErrorTitle:       "Dev webhook trigger error",
 		Version:          1,
 	}
 }
 
-// NewWorkflowDoesNotExistErrorBuilder creates a new error builder for the code "does_not_exist_error".
-func NewWorkflowDoesNotExistErrorBuilder() *WorkflowDoesNotExistErrorBuilder {
-	return &WorkflowDoesNotExistErrorBuilder{arguments: impl.ErrorArguments{}}
+// NewWorkflowWebhookDevTriggerErrorBuilder creates a new error builder for the code "webhook_dev_trigger_error".
+func NewWorkflowWebhookDevTriggerErrorBuilder() *WorkflowWebhookDevTriggerErrorBuilder {
+	return &WorkflowWebhookDevTriggerErrorBuilder{arguments: impl.ErrorArguments{}}
 }
 
-// NewWorkflowDoesNotExistError creates a new error with the code "does_not_exist_error".
-func NewWorkflowDoesNotExistError() Error {
-	return NewWorkflowDoesNotExistErrorBuilder().Build()
+// NewWorkflowWebhookDevTriggerError creates a new error with the code "webhook_dev_trigger_error".
+func NewWorkflowWebhookDevTriggerError() Error {
+	return NewWorkflowWebhookDevTriggerErrorBuilder().Build()
 }
 
-// WorkflowMissingFileFlagErrorCode is the code for an instance of "missing_file_flag_error".
-const WorkflowMissingFileFlagErrorCode = "rcli_workflow_missing_file_flag_error"
+// WorkflowWebhookEndpointUnavailableErrorCode is the code for an instance of "webhook_endpoint_unavailable_error".
+const WorkflowWebhookEndpointUnavailableErrorCode = "rcli_workflow_webhook_endpoint_unavailable_error"
 
-// IsWorkflowMissingFileFlagError tests whether a given error is an instance of "missing_file_flag_error".
-func IsWorkflowMissingFileFlagError(err errawr.Error) bool {
-	return err != nil && err.Is(WorkflowMissingFileFlagErrorCode)
+// IsWorkflowWebhookEndpointUnavailableError tests whether a given error is an instance of "webhook_endpoint_unavailable_error".
+func IsWorkflowWebhookEndpointUnavailableError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowWebhookEndpointUnavailableErrorCode)
 }
 
-// IsWorkflowMissingFileFlagError tests whether a given error is an instance of "missing_file_flag_error".
-func (External) IsWorkflowMissingFileFlagError(err errawr.Error) bool {
-	return IsWorkflowMissingFileFlagError(err)
+// IsWorkflowWebhookEndpointUnavailableError tests whether a given error is an instance of "webhook_endpoint_unavailable_error".
+func (External) IsWorkflowWebhookEndpointUnavailableError(err errawr.Error) bool {
+	return IsWorkflowWebhookEndpointUnavailableError(err)
 }
 
-// WorkflowMissingFileFlagErrorBuilder is a builder for "missing_file_flag_error" errors.
-type WorkflowMissingFileFlagErrorBuilder struct {
+// WorkflowWebhookEndpointUnavailableErrorBuilder is a builder for "webhook_endpoint_unavailable_error" errors.
+type WorkflowWebhookEndpointUnavailableErrorBuilder struct {
 	arguments impl.ErrorArguments
 }
 
-// Build creates the error for the code "missing_file_flag_error" from this builder.
-func (b *WorkflowMissingFileFlagErrorBuilder) Build() Error {
+// Build creates the error for the code "webhook_endpoint_unavailable_error" from this builder.
+func (b *WorkflowWebhookEndpointUnavailableErrorBuilder) Build() Error {
 	description := &impl.ErrorDescription{
-		Friendly:  "You must specify a workflow file with the --file flag.",
-		Technical: "You must specify a workflow file with the --file flag.",
+		Friendly:  "The endpoint for webhook trigger {{ trigger }} is not available yet. Try again once the trigger is ready.",
+		Technical: "The endpoint for webhook trigger {{ trigger }} is not available yet. Try again once the trigger is ready.",
 	}
 
 	return &impl.Error{
 		ErrorArguments:   b.arguments,
-		ErrorCode:        "missing_file_flag_error",
+		ErrorCode:        "webhook_endpoint_unavailable_error",
 		ErrorDescription: description,
 		ErrorDomain:      Domain,
 		ErrorMetadata:    &impl.ErrorMetadata{},
 		ErrorSection:     WorkflowSection,
 		ErrorSensitivity: errawr.ErrorSensitivityNone,
-		ErrorTitle:       "Missing file flag error",
+		ErrorTitle:       "Webhook endpoint unavailable",
 		Version:          1,
 	}
 }
 
-// NewWorkflowMissingFileFlagErrorBuilder creates a new error builder for the code "missing_file_flag_error".
-func NewWorkflowMissingFileFlagErrorBuilder() *WorkflowMissingFileFlagErrorBuilder {
-	return &WorkflowMissingFileFlagErrorBuilder{arguments: impl.ErrorArguments{}}
+// NewWorkflowWebhookEndpointUnavailableErrorBuilder creates a new error builder for the code "webhook_endpoint_unavailable_error".
+func NewWorkflowWebhookEndpointUnavailableErrorBuilder(trigger string) *WorkflowWebhookEndpointUnavailableErrorBuilder {
+	return &WorkflowWebhookEndpointUnavailableErrorBuilder{arguments: impl.ErrorArguments{"trigger": impl.NewErrorArgument(trigger, "The trigger name")}}
 }
 
-// NewWorkflowMissingFileFlagError creates a new error with the code "missing_file_flag_error".
-func NewWorkflowMissingFileFlagError() Error {
-	return NewWorkflowMissingFileFlagErrorBuilder().Build()
+// NewWorkflowWebhookEndpointUnavailableError creates a new error with the code "webhook_endpoint_unavailable_error".
+func NewWorkflowWebhookEndpointUnavailableError(trigger string) Error {
+	return NewWorkflowWebhookEndpointUnavailableErrorBuilder(trigger).Build()
 }
 
-// WorkflowMissingNameErrorCode is the code for an instance of "missing_name_error".
-const WorkflowMissingNameErrorCode = "rcli_workflow_missing_name_error"
+// WorkflowWebhookHeadersReadErrorCode is the code for an instance of "webhook_headers_read_error".
+const WorkflowWebhookHeadersReadErrorCode = "rcli_workflow_webhook_headers_read_error"
 
-// IsWorkflowMissingNameError tests whether a given error is an instance of "missing_name_error".
-func IsWorkflowMissingNameError(err errawr.Error) bool {
-	return err != nil && err.Is(WorkflowMissingNameErrorCode)
+// IsWorkflowWebhookHeadersReadError tests whether a given error is an instance of "webhook_headers_read_error".
+func IsWorkflowWebhookHeadersReadError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowWebhookHeadersReadErrorCode)
 }
 
-// IsWorkflowMissingNameError tests whether a given error is an instance of "missing_name_error".
-func (External) IsWorkflowMissingNameError(err errawr.Error) bool {
-	return IsWorkflowMissingNameError(err)
+// IsWorkflowWebhookHeadersReadError tests whether a given error is an instance of "webhook_headers_read_error".
+func (External) IsWorkflowWebhookHeadersReadError(err errawr.Error) bool {
+	return IsWorkflowWebhookHeadersReadError(err)
 }
 
-// WorkflowMissingNameErrorBuilder is a builder for "missing_name_error" errors.
-type WorkflowMissingNameErrorBuilder struct {
+// WorkflowWebhookHeadersReadErrorBuilder is a builder for "webhook_headers_read_error" errors.
+type WorkflowWebhookHeadersReadErrorBuilder struct {
 	arguments impl.ErrorArguments
 }
 
-// Build creates the error for the code "missing_name_error" from this builder.
-func (b *WorkflowMissingNameErrorBuilder) Build() Error {
+// Build creates the error for the code "webhook_headers_read_error" from this builder.
+func (b *WorkflowWebhookHeadersReadErrorBuilder) Build() Error {
 	description := &impl.ErrorDescription{
-		Friendly:  "Please provide a workflow name.",
-		Technical: "Please provide a workflow name.",
+		Friendly:  "Could not read webhook headers from {{ path }}. Headers must be a JSON object of header names to a value or list of values.",
+		Technical: "Could not read webhook headers from {{ path }}. Headers must be a JSON object of header names to a value or list of values.",
 	}
 
 	return &impl.Error{
 		ErrorArguments:   b.arguments,
-		ErrorCode:        "missing_name_error",
+		ErrorCode:        "webhook_headers_read_error",
 		ErrorDescription: description,
 		ErrorDomain:      Domain,
 		ErrorMetadata:    &impl.ErrorMetadata{},
 		ErrorSection:     WorkflowSection,
 		ErrorSensitivity: errawr.ErrorSensitivityNone,
-		ErrorTitle:       "Missing workflow name error",
+		ErrorTitle:       "Webhook headers read error",
 		Version:          1,
 	}
 }
 
-// NewWorkflowMissingNameErrorBuilder creates a new error builder for the code "missing_name_error".
-func NewWorkflowMissingNameErrorBuilder() *WorkflowMissingNameErrorBuilder {
-	return &WorkflowMissingNameErrorBuilder{arguments: impl.ErrorArguments{}}
+// NewWorkflowWebhookHeadersReadErrorBuilder creates a new error builder for the code "webhook_headers_read_error".
+func NewWorkflowWebhookHeadersReadErrorBuilder(path string) *WorkflowWebhookHeadersReadErrorBuilder {
+	return &WorkflowWebhookHeadersReadErrorBuilder{arguments: impl.ErrorArguments{"path": impl.NewErrorArgument(path, "User provided headers file")}}
 }
 
-// NewWorkflowMissingNameError creates a new error with the code "missing_name_error".
-func NewWorkflowMissingNameError() Error {
-	return NewWorkflowMissingNameErrorBuilder().Build()
+// NewWorkflowWebhookHeadersReadError creates a new error with the code "webhook_headers_read_error".
+func NewWorkflowWebhookHeadersReadError(path string) Error {
+	return NewWorkflowWebhookHeadersReadErrorBuilder(path).Build()
+}
+
+// WorkflowWebhookPayloadReadErrorCode is the code for an instance of "webhook_payload_read_error".
+const WorkflowWebhookPayloadReadErrorCode = "rcli_workflow_webhook_payload_read_error"
+
+// IsWorkflowWebhookPayloadReadError tests whether a given error is an instance of "webhook_payload_read_error".
+func IsWorkflowWebhookPayloadReadError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowWebhookPayloadReadErrorCode)
+}
+
+// IsWorkflowWebhookPayloadReadError tests whether a given error is an instance of "webhook_payload_read_error".
+func (External) IsWorkflowWebhookPayloadReadError(err errawr.Error) bool {
+	return IsWorkflowWebhookPayloadReadError(err)
+}
+
+// WorkflowWebhookPayloadReadErrorBuilder is a builder for "webhook_payload_read_error" errors.
+type WorkflowWebhookPayloadReadErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "webhook_payload_read_error" from this builder.
+func (b *WorkflowWebhookPayloadReadErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not read the webhook payload from {{ path }}.",
+		Technical: "Could not read the webhook payload from {{ path }}.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "webhook_payload_read_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Webhook payload read error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowWebhookPayloadReadErrorBuilder creates a new error builder for the code "webhook_payload_read_error".
+func NewWorkflowWebhookPayloadReadErrorBuilder(path string) *WorkflowWebhookPayloadReadErrorBuilder {
+	return &WorkflowWebhookPayloadReadErrorBuilder{arguments: impl.ErrorArguments{"path": impl.NewErrorArgument(path, "User provided payload file")}}
+}
+
+// NewWorkflowWebhookPayloadReadError creates a new error with the code "webhook_payload_read_error".
+func NewWorkflowWebhookPayloadReadError(path string) Error {
+	return NewWorkflowWebhookPayloadReadErrorBuilder(path).Build()
+}
+
+// WorkflowWebhookReplayFailedErrorCode is the code for an instance of "webhook_replay_failed_error".
+const WorkflowWebhookReplayFailedErrorCode = "rcli_workflow_webhook_replay_failed_error"
+
+// IsWorkflowWebhookReplayFailedError tests whether a given error is an instance of "webhook_replay_failed_error".
+func IsWorkflowWebhookReplayFailedError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowWebhookReplayFailedErrorCode)
+}
+
+// IsWorkflowWebhookReplayFailedError tests whether a given error is an instance of "webhook_replay_failed_error".
+func (External) IsWorkflowWebhookReplayFailedError(err errawr.Error) bool {
+	return IsWorkflowWebhookReplayFailedError(err)
+}
+
+// WorkflowWebhookReplayFailedErrorBuilder is a builder for "webhook_replay_failed_error" errors.
+type WorkflowWebhookReplayFailedErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "webhook_replay_failed_error" from this builder.
+func (b *WorkflowWebhookReplayFailedErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "The webhook endpoint responded with status {{ status }}.",
+		Technical: "The webhook endpoint responded with status {{ status }}.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "webhook_replay_failed_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Webhook replay failed",
+		Version:          1,
+	}
+}
+
+// NewWorkflowWebhookReplayFailedErrorBuilder creates a new error builder for the code "webhook_replay_failed_error".
+func NewWorkflowWebhookReplayFailedErrorBuilder(status string) *WorkflowWebhookReplayFailedErrorBuilder {
+	return &WorkflowWebhookReplayFailedErrorBuilder{arguments: impl.ErrorArguments{"status": impl.NewErrorArgument(status, "The HTTP status of the response")}}
+}
+
+// NewWorkflowWebhookReplayFailedError creates a new error with the code "webhook_replay_failed_error".
+func NewWorkflowWebhookReplayFailedError(status string) Error {
+	return NewWorkflowWebhookReplayFailedErrorBuilder(status).Build()
+}
+
+// WorkflowWorkflowFileDecodeErrorCode is the code for an instance of "workflow_file_decode_error".
+const WorkflowWorkflowFileDecodeErrorCode = "rcli_workflow_workflow_file_decode_error"
+
+// IsWorkflowWorkflowFileDecodeError tests whether a given error is an instance of "workflow_file_decode_error".
+func IsWorkflowWorkflowFileDecodeError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowWorkflowFileDecodeErrorCode)
+}
+
+// IsWorkflowWorkflowFileDecodeError tests whether a given error is an instance of "workflow_file_decode_error".
+func (External) IsWorkflowWorkflowFileDecodeError(err errawr.Error) bool {
+	return IsWorkflowWorkflowFileDecodeError(err)
+}
+
+// WorkflowWorkflowFileDecodeErrorBuilder is a builder for "workflow_file_decode_error" errors.
+type WorkflowWorkflowFileDecodeErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "workflow_file_decode_error" from this builder.
+func (b *WorkflowWorkflowFileDecodeErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not parse workflow file {{ path }}. Check that it is valid YAML.",
+		Technical: "Could not parse workflow file {{ path }}. Check that it is valid YAML.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "workflow_file_decode_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Workflow file decode error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowWorkflowFileDecodeErrorBuilder creates a new error builder for the code "workflow_file_decode_error".
+func NewWorkflowWorkflowFileDecodeErrorBuilder(path string) *WorkflowWorkflowFileDecodeErrorBuilder {
+	return &WorkflowWorkflowFileDecodeErrorBuilder{arguments: impl.ErrorArguments{"path": impl.NewErrorArgument(path, "User provided workflow file path")}}
+}
+
+// NewWorkflowWorkflowFileDecodeError creates a new error with the code "workflow_file_decode_error".
+func NewWorkflowWorkflowFileDecodeError(path string) Error {
+	return NewWorkflowWorkflowFileDecodeErrorBuilder(path).Build()
 }
 
 // WorkflowWorkflowFileReadErrorCode is the code for an instance of "workflow_file_read_error".
@@ -1507,6 +4869,54 @@ func NewWorkflowWorkflowFileReadError() Error {
 	return NewWorkflowWorkflowFileReadErrorBuilder().Build()
 }
 
+// WorkflowWorkflowFileWriteErrorCode is the code for an instance of "workflow_file_write_error".
+const WorkflowWorkflowFileWriteErrorCode = "rcli_workflow_workflow_file_write_error"
+
+// IsWorkflowWorkflowFileWriteError tests whether a given error is an instance of "workflow_file_write_error".
+func IsWorkflowWorkflowFileWriteError(err errawr.Error) bool {
+	return err != nil && err.Is(WorkflowWorkflowFileWriteErrorCode)
+}
+
+// IsWorkflowWorkflowFileWriteError tests whether a given error is an instance of "workflow_file_write_error".
+func (External) IsWorkflowWorkflowFileWriteError(err errawr.Error) bool {
+	return IsWorkflowWorkflowFileWriteError(err)
+}
+
+// WorkflowWorkflowFileWriteErrorBuilder is a builder for "workflow_file_write_error" errors.
+type WorkflowWorkflowFileWriteErrorBuilder struct {
+	arguments impl.ErrorArguments
+}
+
+// Build creates the error for the code "workflow_file_write_error" from this builder.
+func (b *WorkflowWorkflowFileWriteErrorBuilder) Build() Error {
+	description := &impl.ErrorDescription{
+		Friendly:  "Could not write workflow file {{ path }}.",
+		Technical: "Could not write workflow file {{ path }}.",
+	}
+
+	return &impl.Error{
+		ErrorArguments:   b.arguments,
+		ErrorCode:        "workflow_file_write_error",
+		ErrorDescription: description,
+		ErrorDomain:      Domain,
+		ErrorMetadata:    &impl.ErrorMetadata{},
+		ErrorSection:     WorkflowSection,
+		ErrorSensitivity: errawr.ErrorSensitivityNone,
+		ErrorTitle:       "Workflow file write error",
+		Version:          1,
+	}
+}
+
+// NewWorkflowWorkflowFileWriteErrorBuilder creates a new error builder for the code "workflow_file_write_error".
+func NewWorkflowWorkflowFileWriteErrorBuilder(path string) *WorkflowWorkflowFileWriteErrorBuilder {
+	return &WorkflowWorkflowFileWriteErrorBuilder{arguments: impl.ErrorArguments{"path": impl.NewErrorArgument(path, "Path of the workflow file")}}
+}
+
+// NewWorkflowWorkflowFileWriteError creates a new error with the code "workflow_file_write_error".
+func NewWorkflowWorkflowFileWriteError(path string) Error {
+	return NewWorkflowWorkflowFileWriteErrorBuilder(path).Build()
+}
+
 // WorkflowWorkflowNameReadErrorCode is the code for an instance of "workflow_name_read_error".
 const WorkflowWorkflowNameReadErrorCode = "rcli_workflow_workflow_name_read_error"
 
@@ -0,0 +1,188 @@
+package format
+
+import (
+	"bytes"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// workflowKeyOrder is the canonical order of the top-level keys of a workflow
+// file. Other keys are kept in their original order after apiVersion, name and
+// description, so that anchors they define still come before their aliases.
+var workflowKeyOrder = []string{"apiVersion", "version", "name", "description", "", "parameters", "triggers", "steps"}
+
+// stepKeyOrder is the canonical order of the leading keys of steps and
+// triggers. Other keys follow in their original order.
+var stepKeyOrder = []string{"name", "description", "type", "image", "dependsOn", "when", ""}
+
+// Workflow rewrites a workflow file with canonical key ordering, indentation
+// and quoting. Comments are preserved. Each document of a file with more than
+// one is formatted in turn.
+func Workflow(content []byte) ([]byte, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(content))
+
+	var docs [][]byte
+
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		formatted, err := workflowDocument(&doc)
+		if err != nil {
+			return nil, err
+		}
+
+		docs = append(docs, formatted)
+	}
+
+	if len(docs) == 0 {
+		return content, nil
+	}
+
+	return bytes.Join(docs, []byte("---\n")), nil
+}
+
+func workflowDocument(doc *yaml.Node) ([]byte, error) {
+	normalizeScalars(doc)
+
+	plain, err := encodeWorkflow(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return plain, nil
+	}
+
+	// A comment before the first key usually describes the whole file, so it
+	// stays at the top rather than moving with the key.
+	var header string
+	if len(root.Content) > 0 {
+		header = root.Content[0].HeadComment
+		root.Content[0].HeadComment = ""
+	}
+
+	sortMapping(root, workflowKeyOrder)
+
+	if len(root.Content) > 0 && header != "" {
+		root.Content[0].HeadComment = joinComments(header, root.Content[0].HeadComment)
+	}
+
+	for _, key := range []string{"steps", "triggers"} {
+		if items := mappingValue(root, key); items != nil && items.Kind == yaml.SequenceNode {
+			for _, item := range items.Content {
+				if item.Kind == yaml.MappingNode {
+					sortMapping(item, stepKeyOrder)
+				}
+			}
+		}
+	}
+
+	sorted, err := encodeWorkflow(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Moving keys can place an alias before the anchor it refers to, which
+	// is not valid YAML. Keep the original order when that happens.
+	var check yaml.Node
+	if err := yaml.Unmarshal(sorted, &check); err != nil {
+		return plain, nil
+	}
+
+	return sorted, nil
+}
+
+func joinComments(a, b string) string {
+	if b == "" {
+		return a
+	}
+
+	return a + "\n\n" + b
+}
+
+func encodeWorkflow(doc *yaml.Node) ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// normalizeScalars drops unnecessary quotes from scalars, leaving the encoder
+// to quote only the values that would otherwise change type.
+func normalizeScalars(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode && node.Style&(yaml.SingleQuotedStyle|yaml.DoubleQuotedStyle) != 0 {
+		node.Style &^= yaml.SingleQuotedStyle | yaml.DoubleQuotedStyle
+	}
+
+	for _, child := range node.Content {
+		normalizeScalars(child)
+	}
+}
+
+// sortMapping reorders the keys of a mapping node. The empty string in order
+// marks where keys that are not listed are placed.
+func sortMapping(node *yaml.Node, order []string) {
+	pairs := make(map[string][]*yaml.Node, len(node.Content)/2)
+
+	var rest []*yaml.Node
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+
+		if listed(order, key.Value) {
+			pairs[key.Value] = []*yaml.Node{key, value}
+		} else {
+			rest = append(rest, key, value)
+		}
+	}
+
+	content := make([]*yaml.Node, 0, len(node.Content))
+
+	for _, key := range order {
+		if key == "" {
+			content = append(content, rest...)
+			continue
+		}
+
+		content = append(content, pairs[key]...)
+	}
+
+	node.Content = content
+}
+
+func listed(order []string, key string) bool {
+	for _, k := range order {
+		if k != "" && k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
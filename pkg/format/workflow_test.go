@@ -0,0 +1,107 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflow(t *testing.T) {
+	in := `# Deploys the app
+ref: &image alpine:latest
+steps:
+    # build first
+    -   image: *image
+        name: "build"   # the build
+        input:
+        - |
+          echo "hi"
+    - name: deploy
+      dependsOn: build
+      spec:
+        version: "1.0"
+        out: !Output {from: build, name: image}
+parameters:
+    message: {default: 'hello'}
+apiVersion: v1
+description: "A workflow"
+`
+
+	expected := `# Deploys the app
+apiVersion: v1
+description: A workflow
+ref: &image alpine:latest
+parameters:
+  message: {default: hello}
+steps:
+  # build first
+  - name: build # the build
+    image: *image
+    input:
+      - |
+        echo "hi"
+  - name: deploy
+    dependsOn: build
+    spec:
+      version: "1.0"
+      out: !Output {from: build, name: image}
+`
+
+	out, err := Workflow([]byte(in))
+	require.NoError(t, err)
+	require.Equal(t, expected, string(out))
+
+	again, err := Workflow(out)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(again))
+}
+
+func TestWorkflowKeepsOrderForAliases(t *testing.T) {
+	in := `steps:
+  - name: build
+    image: &image alpine:latest
+triggers:
+  - name: push
+    source:
+      type: push
+      image: *image
+`
+
+	out, err := Workflow([]byte(in))
+	require.NoError(t, err)
+	require.Equal(t, in, string(out))
+}
+
+func TestWorkflowMultipleDocuments(t *testing.T) {
+	in := `steps:
+- image: alpine:latest
+  name: first
+apiVersion: v1
+---
+# the second workflow
+steps:
+- image: alpine:latest
+  name: second
+apiVersion: v1
+`
+
+	expected := `apiVersion: v1
+steps:
+  - name: first
+    image: alpine:latest
+---
+# the second workflow
+apiVersion: v1
+steps:
+  - name: second
+    image: alpine:latest
+`
+
+	out, err := Workflow([]byte(in))
+	require.NoError(t, err)
+	require.Equal(t, expected, string(out))
+
+	again, err := Workflow(out)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(again))
+}
@@ -0,0 +1,128 @@
+package graph
+
+import (
+	"regexp"
+
+	"github.com/puppetlabs/relay/pkg/model"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// templatePattern matches the ${...} expressions in a string value.
+	templatePattern = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+	// templateOutputPattern matches outputs.step.name, with either part
+	// optionally written as ["part"], within an expression.
+	templateOutputPattern = regexp.MustCompile(`(?:^|[^\w.])outputs(?:\.(\w+)|\[\s*["']([^"']+)["']\s*\])(?:\.(\w+)|\[\s*["']([^"']+)["']\s*\])`)
+)
+
+// StepsFromFile reads the steps of a local workflow file, finding the outputs
+// each step uses so the file can be drawn without contacting Relay.
+func StepsFromFile(content []byte) ([]*model.WorkflowStep, error) {
+	var wf struct {
+		Steps []yaml.Node `yaml:"steps"`
+	}
+
+	if err := yaml.Unmarshal(content, &wf); err != nil {
+		return nil, err
+	}
+
+	var steps []*model.WorkflowStep
+
+	for i := range wf.Steps {
+		node := &wf.Steps[i]
+
+		var step struct {
+			Name      string    `yaml:"name"`
+			Type      string    `yaml:"type"`
+			DependsOn yaml.Node `yaml:"dependsOn"`
+		}
+
+		if err := node.Decode(&step); err != nil {
+			return nil, err
+		}
+
+		ws := &model.WorkflowStep{
+			Name: step.Name,
+			Type: step.Type,
+		}
+
+		switch step.DependsOn.Kind {
+		case yaml.ScalarNode:
+			ws.DependsOn = []string{step.DependsOn.Value}
+		case yaml.SequenceNode:
+			if err := step.DependsOn.Decode(&ws.DependsOn); err != nil {
+				return nil, err
+			}
+		}
+
+		var outputs []*model.WorkflowOutputReference
+		collectOutputReferences(node, &outputs)
+
+		if len(outputs) > 0 {
+			ws.References = &model.WorkflowDataReferences{Outputs: outputs}
+		}
+
+		steps = append(steps, ws)
+	}
+
+	return steps, nil
+}
+
+// collectOutputReferences finds references to step outputs, written as !Output
+// tags, as {$type: Output} mappings or as ${outputs.step.name} expressions in
+// strings.
+func collectOutputReferences(node *yaml.Node, outputs *[]*model.WorkflowOutputReference) {
+	if node == nil {
+		return
+	}
+
+	switch node.Kind {
+	case yaml.AliasNode:
+		collectOutputReferences(node.Alias, outputs)
+		return
+	case yaml.ScalarNode:
+		for _, expr := range templatePattern.FindAllStringSubmatch(node.Value, -1) {
+			for _, match := range templateOutputPattern.FindAllStringSubmatch(expr[1], -1) {
+				*outputs = append(*outputs, &model.WorkflowOutputReference{
+					From: match[1] + match[2],
+					Name: match[3] + match[4],
+				})
+			}
+		}
+
+		return
+	case yaml.MappingNode:
+		if node.Tag == "!Output" || mappingScalar(node, "$type") == "Output" {
+			*outputs = append(*outputs, &model.WorkflowOutputReference{
+				From: mappingScalar(node, "from"),
+				Name: mappingScalar(node, "name"),
+			})
+
+			return
+		}
+	case yaml.SequenceNode:
+		if node.Tag == "!Output" && len(node.Content) == 2 {
+			*outputs = append(*outputs, &model.WorkflowOutputReference{
+				From: node.Content[0].Value,
+				Name: node.Content[1].Value,
+			})
+
+			return
+		}
+	}
+
+	for _, child := range node.Content {
+		collectOutputReferences(child, outputs)
+	}
+}
+
+func mappingScalar(node *yaml.Node, key string) string {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key && node.Content[i+1].Kind == yaml.ScalarNode {
+			return node.Content[i+1].Value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,158 @@
+// Package graph renders the steps of a Relay workflow and the dependencies
+// between them as a diagram.
+package graph
+
+import (
+	"sort"
+
+	"github.com/puppetlabs/relay/pkg/model"
+)
+
+const approvalStepType = "approval"
+
+type EdgeKind string
+
+const (
+	// EdgeKindDependency is an explicit dependency declared with dependsOn.
+	EdgeKindDependency EdgeKind = "dependency"
+	// EdgeKindOutput passes an output of one step to another.
+	EdgeKindOutput EdgeKind = "output"
+)
+
+type Node struct {
+	Name     string
+	Approval bool
+}
+
+// Edge connects a step to a step that runs after it. Output edges are labelled
+// with the name of the output.
+type Edge struct {
+	From  string
+	To    string
+	Kind  EdgeKind
+	Label string
+}
+
+type Graph struct {
+	Nodes []*Node
+	Edges []*Edge
+}
+
+// New builds a graph from workflow steps, keeping the order the steps are
+// defined in. Edges to steps that do not exist are dropped.
+func New(steps []*model.WorkflowStep) *Graph {
+	g := &Graph{}
+
+	known := make(map[string]bool, len(steps))
+
+	for _, step := range steps {
+		if step == nil || known[step.Name] {
+			continue
+		}
+
+		known[step.Name] = true
+		g.Nodes = append(g.Nodes, &Node{Name: step.Name, Approval: step.Type == approvalStepType})
+	}
+
+	seen := make(map[Edge]bool)
+
+	add := func(e Edge) {
+		if !known[e.From] || !known[e.To] || seen[e] {
+			return
+		}
+
+		seen[e] = true
+		g.Edges = append(g.Edges, &e)
+	}
+
+	for _, step := range steps {
+		if step == nil {
+			continue
+		}
+
+		for _, dep := range step.DependsOn {
+			add(Edge{From: dep, To: step.Name, Kind: EdgeKindDependency})
+		}
+
+		if step.References == nil {
+			continue
+		}
+
+		outputs := append([]*model.WorkflowOutputReference{}, step.References.Outputs...)
+		sort.SliceStable(outputs, func(i, j int) bool {
+			if outputs[i].From != outputs[j].From {
+				return outputs[i].From < outputs[j].From
+			}
+
+			return outputs[i].Name < outputs[j].Name
+		})
+
+		for _, output := range outputs {
+			add(Edge{From: output.From, To: step.Name, Kind: EdgeKindOutput, Label: output.Name})
+		}
+	}
+
+	return g
+}
+
+// Stages groups the steps into the order they can run in: every step runs
+// after all of the steps it depends on or takes outputs from. Steps that are
+// part of a dependency cycle cannot be ordered and are returned separately.
+func (g *Graph) Stages() (stages [][]*Node, cyclic []*Node) {
+	remaining := make(map[string]int, len(g.Nodes))
+	for _, node := range g.Nodes {
+		remaining[node.Name] = 0
+	}
+
+	for _, edge := range g.Edges {
+		remaining[edge.To]++
+	}
+
+	done := make(map[string]bool, len(g.Nodes))
+
+	for {
+		var stage []*Node
+		for _, node := range g.Nodes {
+			if !done[node.Name] && remaining[node.Name] == 0 {
+				stage = append(stage, node)
+			}
+		}
+
+		if len(stage) == 0 {
+			break
+		}
+
+		for _, node := range stage {
+			done[node.Name] = true
+
+			for _, edge := range g.Edges {
+				if edge.From == node.Name {
+					remaining[edge.To]--
+				}
+			}
+		}
+
+		stages = append(stages, stage)
+	}
+
+	for _, node := range g.Nodes {
+		if !done[node.Name] {
+			cyclic = append(cyclic, node)
+		}
+	}
+
+	return
+}
+
+// incoming returns the edges that end at the named step.
+func (g *Graph) incoming(name string) []*Edge {
+	var edges []*Edge
+
+	for _, edge := range g.Edges {
+		if edge.To == name {
+			edges = append(edges, edge)
+		}
+	}
+
+	return edges
+}
@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testWorkflow = `steps:
+- name: build
+  image: alpine:latest
+- name: test
+  image: alpine:latest
+  dependsOn: build
+- name: approve
+  type: approval
+  dependsOn: [test]
+- name: deploy
+  image: alpine:latest
+  dependsOn: approve
+  spec:
+    image: !Output {from: build, name: image}
+`
+
+func TestRender(t *testing.T) {
+	steps, err := StepsFromFile([]byte(testWorkflow))
+	require.NoError(t, err)
+
+	g := New(steps)
+
+	require.Equal(t, `graph TD
+  s0["build"]
+  s1["test"]
+  s2{"approve"}
+  s3["deploy"]
+  s0 --> s1
+  s1 --> s2
+  s2 --> s3
+  s0 -. "image" .-> s3
+`, Mermaid(g))
+
+	require.Equal(t, `digraph workflow {
+  node [shape=box];
+  "build";
+  "test";
+  "approve" [shape=diamond];
+  "deploy";
+  "build" -> "test";
+  "test" -> "approve";
+  "approve" -> "deploy";
+  "build" -> "deploy" [style=dashed, label="image"];
+}
+`, DOT(g))
+
+	require.Equal(t, `Stage 1
+└── build
+Stage 2
+└── test                <- build
+Stage 3
+└── approve (approval)  <- test
+Stage 4
+└── deploy              <- approve, build.image
+`, ASCII(g))
+}
+
+func TestStepsFromFileTemplateOutputs(t *testing.T) {
+	steps, err := StepsFromFile([]byte(`steps:
+- name: build
+  image: alpine:latest
+- name: deploy
+  image: alpine:latest
+  input:
+  - deploy "${outputs.build.image}" "${outputs['build']["tag"]}"
+  - echo "$outputs.build.ignored"
+`))
+	require.NoError(t, err)
+
+	require.Nil(t, steps[0].References)
+	require.NotNil(t, steps[1].References)
+
+	var refs []string
+	for _, ref := range steps[1].References.Outputs {
+		refs = append(refs, ref.From+"."+ref.Name)
+	}
+
+	require.Equal(t, []string{"build.image", "build.tag"}, refs)
+	require.Contains(t, Mermaid(New(steps)), `s0 -. "image" .-> s1`)
+}
+
+func TestStagesWithCycle(t *testing.T) {
+	steps, err := StepsFromFile([]byte(`steps:
+- name: a
+  dependsOn: b
+- name: b
+  dependsOn: a
+- name: c
+`))
+	require.NoError(t, err)
+
+	stages, cyclic := New(steps).Stages()
+	require.Len(t, stages, 1)
+	require.Equal(t, "c", stages[0][0].Name)
+	require.Len(t, cyclic, 2)
+}
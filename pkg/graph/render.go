@@ -0,0 +1,164 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	FormatMermaid = "mermaid"
+	FormatDOT     = "dot"
+	FormatASCII   = "ascii"
+)
+
+// Formats lists the supported diagram formats.
+var Formats = []string{FormatMermaid, FormatDOT, FormatASCII}
+
+// Render draws the graph in the given format, returning false if the format is
+// not supported.
+func Render(g *Graph, format string) (string, bool) {
+	switch format {
+	case FormatMermaid:
+		return Mermaid(g), true
+	case FormatDOT:
+		return DOT(g), true
+	case FormatASCII:
+		return ASCII(g), true
+	}
+
+	return "", false
+}
+
+// Mermaid draws the graph as a Mermaid flowchart. Dependencies are solid
+// arrows and output edges are dotted arrows labelled with the output name.
+func Mermaid(g *Graph) string {
+	var b strings.Builder
+
+	ids := make(map[string]string, len(g.Nodes))
+
+	b.WriteString("graph TD\n")
+
+	for i, node := range g.Nodes {
+		id := fmt.Sprintf("s%d", i)
+		ids[node.Name] = id
+
+		label := mermaidEscape(node.Name)
+		if node.Approval {
+			fmt.Fprintf(&b, "  %s{\"%s\"}\n", id, label)
+		} else {
+			fmt.Fprintf(&b, "  %s[\"%s\"]\n", id, label)
+		}
+	}
+
+	for _, edge := range g.Edges {
+		switch edge.Kind {
+		case EdgeKindOutput:
+			fmt.Fprintf(&b, "  %s -. \"%s\" .-> %s\n", ids[edge.From], mermaidEscape(edge.Label), ids[edge.To])
+		default:
+			fmt.Fprintf(&b, "  %s --> %s\n", ids[edge.From], ids[edge.To])
+		}
+	}
+
+	return b.String()
+}
+
+func mermaidEscape(s string) string {
+	return strings.ReplaceAll(s, `"`, "#quot;")
+}
+
+// DOT draws the graph in the Graphviz DOT language. Dependencies are solid
+// edges and output edges are dashed edges labelled with the output name.
+func DOT(g *Graph) string {
+	var b strings.Builder
+
+	b.WriteString("digraph workflow {\n")
+	b.WriteString("  node [shape=box];\n")
+
+	for _, node := range g.Nodes {
+		if node.Approval {
+			fmt.Fprintf(&b, "  %s [shape=diamond];\n", dotQuote(node.Name))
+		} else {
+			fmt.Fprintf(&b, "  %s;\n", dotQuote(node.Name))
+		}
+	}
+
+	for _, edge := range g.Edges {
+		switch edge.Kind {
+		case EdgeKindOutput:
+			fmt.Fprintf(&b, "  %s -> %s [style=dashed, label=%s];\n", dotQuote(edge.From), dotQuote(edge.To), dotQuote(edge.Label))
+		default:
+			fmt.Fprintf(&b, "  %s -> %s;\n", dotQuote(edge.From), dotQuote(edge.To))
+		}
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// ASCII draws the graph as a list of stages, each containing the steps that
+// can run once the previous stages have finished, along with the steps each
+// one waits for.
+func ASCII(g *Graph) string {
+	var b strings.Builder
+
+	stages, cyclic := g.Stages()
+
+	label := func(node *Node) string {
+		if node.Approval {
+			return node.Name + " (approval)"
+		}
+
+		return node.Name
+	}
+
+	width := 0
+	for _, node := range g.Nodes {
+		if l := len(label(node)); l > width {
+			width = l
+		}
+	}
+
+	writeNodes := func(nodes []*Node) {
+		for i, node := range nodes {
+			branch := "├──"
+			if i == len(nodes)-1 {
+				branch = "└──"
+			}
+
+			name := label(node)
+
+			var from []string
+			for _, edge := range g.incoming(node.Name) {
+				if edge.Kind == EdgeKindOutput {
+					from = append(from, fmt.Sprintf("%s.%s", edge.From, edge.Label))
+				} else {
+					from = append(from, edge.From)
+				}
+			}
+
+			if len(from) == 0 {
+				fmt.Fprintf(&b, "%s %s\n", branch, name)
+				continue
+			}
+
+			fmt.Fprintf(&b, "%s %-*s  <- %s\n", branch, width, name, strings.Join(from, ", "))
+		}
+	}
+
+	for i, stage := range stages {
+		fmt.Fprintf(&b, "Stage %d\n", i+1)
+		writeNodes(stage)
+	}
+
+	if len(cyclic) > 0 {
+		b.WriteString("Dependency cycle\n")
+		writeNodes(cyclic)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,516 @@
+// Package lint checks Relay workflow files for problems without contacting the
+// Relay API.
+package lint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/puppetlabs/relay-client-go/models/pkg/workflow/types/v1"
+	"github.com/puppetlabs/relay-core/pkg/util/typeutil"
+	"gopkg.in/yaml.v3"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Rule describes a single kind of problem the linter can find.
+type Rule struct {
+	ID          string
+	Description string
+	Severity    Severity
+}
+
+var (
+	RuleSyntax = &Rule{
+		ID:          "syntax",
+		Description: "The workflow file must be valid YAML.",
+		Severity:    SeverityError,
+	}
+	RuleSchema = &Rule{
+		ID:          "schema",
+		Description: "The workflow file must match the workflow schema.",
+		Severity:    SeverityError,
+	}
+	RuleStructure = &Rule{
+		ID:          "structure",
+		Description: "The workflow file must decode to a valid workflow.",
+		Severity:    SeverityError,
+	}
+	RuleDuplicateStepName = &Rule{
+		ID:          "duplicate-step-name",
+		Description: "Each step in a workflow must have a unique name.",
+		Severity:    SeverityError,
+	}
+	RuleUnknownDependency = &Rule{
+		ID:          "unknown-dependency",
+		Description: "A step may only depend on steps defined in the same workflow.",
+		Severity:    SeverityError,
+	}
+	RuleDependencyCycle = &Rule{
+		ID:          "dependency-cycle",
+		Description: "Step dependencies must not form a cycle.",
+		Severity:    SeverityError,
+	}
+	RuleInvalidSchedule = &Rule{
+		ID:          "invalid-schedule",
+		Description: "Schedule triggers must use a valid cron expression.",
+		Severity:    SeverityError,
+	}
+	RuleUnusedParameter = &Rule{
+		ID:          "unused-parameter",
+		Description: "Declared parameters should be referenced by a step or trigger.",
+		Severity:    SeverityWarning,
+	}
+)
+
+// Rules lists every rule the linter checks.
+var Rules = []*Rule{
+	RuleSyntax,
+	RuleSchema,
+	RuleStructure,
+	RuleDuplicateStepName,
+	RuleUnknownDependency,
+	RuleDependencyCycle,
+	RuleInvalidSchedule,
+	RuleUnusedParameter,
+}
+
+// Finding is a problem found in a workflow file. Line and column numbers start
+// at 1.
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+	Line     int      `json:"line"`
+	Column   int      `json:"column"`
+}
+
+func newFinding(rule *Rule, node *yaml.Node, format string, args ...interface{}) *Finding {
+	f := &Finding{
+		Rule:     rule.ID,
+		Severity: rule.Severity,
+		Message:  fmt.Sprintf(format, args...),
+		Line:     1,
+		Column:   1,
+	}
+
+	if node != nil && node.Line > 0 {
+		f.Line = node.Line
+		f.Column = node.Column
+	}
+
+	return f
+}
+
+var syntaxErrorLinePattern = regexp.MustCompile(`line (\d+)`)
+
+var (
+	// templatePattern matches the ${...} expressions in a string value.
+	templatePattern = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+	// templateParameterPattern matches parameters.name and parameters["name"]
+	// within an expression.
+	templateParameterPattern = regexp.MustCompile(`(?:^|[^\w.])parameters(?:\.(\w+)|\[\s*["']([^"']+)["']\s*\])`)
+)
+
+// Lint reads a workflow file and returns the problems found in it, ordered by
+// their position in the file. An error is only returned if the file cannot be
+// read.
+func Lint(ctx context.Context, r io.Reader) ([]*Finding, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		f := newFinding(RuleSyntax, nil, "%s", strings.TrimPrefix(err.Error(), "yaml: "))
+		if m := syntaxErrorLinePattern.FindStringSubmatch(err.Error()); m != nil {
+			f.Line, _ = strconv.Atoi(m[1])
+		}
+
+		return []*Finding{f}, nil
+	}
+
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return []*Finding{newFinding(RuleStructure, nil, "the workflow file must contain a YAML mapping")}, nil
+	}
+
+	l := &linter{root: doc.Content[0]}
+
+	l.checkSchema(string(b))
+
+	// The decoder reports many of the same problems as the schema, so only
+	// surface its errors when the schema found nothing more specific.
+	decoder := v1.NewDocumentStreamingDecoder(ioutil.NopCloser(bytes.NewReader(b)), &v1.YAMLDecoder{})
+
+	wd, err := decoder.DecodeStream(ctx)
+	if err != nil && err != io.EOF {
+		if len(l.findings) == 0 {
+			l.addDecodeError(err)
+		}
+	} else {
+		l.checkSteps(wd)
+		l.checkTriggers(wd)
+		l.checkParameters()
+	}
+
+	sort.SliceStable(l.findings, func(i, j int) bool {
+		a, b := l.findings[i], l.findings[j]
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+
+		return a.Column < b.Column
+	})
+
+	return l.findings, nil
+}
+
+// Locate finds the line and column of the value at a path into a workflow
+// file, such as steps.0.name. Path components may be separated by dots or
+// slashes. If the path cannot be fully resolved, the position of the deepest
+// value found is returned.
+func Locate(content []byte, path string) (line, column int) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil || len(doc.Content) == 0 {
+		return 1, 1
+	}
+
+	path = strings.Trim(strings.ReplaceAll(path, "/", "."), ".")
+
+	node := lookupPath(doc.Content[0], path)
+
+	return node.Line, node.Column
+}
+
+type linter struct {
+	root     *yaml.Node
+	findings []*Finding
+}
+
+func (l *linter) add(rule *Rule, node *yaml.Node, format string, args ...interface{}) {
+	l.findings = append(l.findings, newFinding(rule, node, format, args...))
+}
+
+func (l *linter) checkSchema(content string) {
+	err := v1.ValidateYAML(content)
+	if err == nil {
+		return
+	}
+
+	ve, ok := err.(*typeutil.ValidationError)
+	if !ok {
+		l.add(RuleSchema, nil, "%s", err.Error())
+		return
+	}
+
+	for _, fe := range ve.FieldErrors {
+		l.add(RuleSchema, lookupPath(l.root, fe.Context), "%s", fe.Description)
+	}
+}
+
+func (l *linter) addDecodeError(err error) {
+	switch et := err.(type) {
+	case *v1.WorkflowStepInvalidError:
+		node := l.root
+		for _, step := range sequenceItems(mappingValue(l.root, "steps")) {
+			if scalarValue(mappingValue(step, "name")) == et.Name {
+				node = mappingValue(step, "type")
+				break
+			}
+		}
+
+		l.add(RuleStructure, node, "step %s has unknown type %q", et.Name, et.Type)
+	case *v1.WorkflowFileFormatError:
+		f := newFinding(RuleStructure, nil, "%s", strings.TrimPrefix(et.Cause.Error(), "yaml: "))
+		if m := syntaxErrorLinePattern.FindStringSubmatch(et.Cause.Error()); m != nil {
+			f.Line, _ = strconv.Atoi(m[1])
+		}
+
+		l.findings = append(l.findings, f)
+	default:
+		l.add(RuleStructure, nil, "%s", err.Error())
+	}
+}
+
+func (l *linter) checkSteps(wd *v1.WorkflowData) {
+	nodes := sequenceItems(mappingValue(l.root, "steps"))
+	if len(nodes) != len(wd.Steps) {
+		return
+	}
+
+	index := make(map[string]int, len(wd.Steps))
+
+	for i, step := range wd.Steps {
+		if step.Name == "" {
+			continue
+		}
+
+		if _, ok := index[step.Name]; ok {
+			l.add(RuleDuplicateStepName, mappingValue(nodes[i], "name"), "step name %s is used by more than one step", step.Name)
+			continue
+		}
+
+		index[step.Name] = i
+	}
+
+	graph := make(map[string][]string, len(index))
+
+	for i, step := range wd.Steps {
+		for _, dep := range step.DependsOn {
+			if _, ok := index[dep]; !ok {
+				l.add(RuleUnknownDependency, dependencyNode(nodes[i], dep), "step %s depends on %s, which is not a step in this workflow", step.Name, dep)
+				continue
+			}
+
+			if index[step.Name] == i {
+				graph[step.Name] = append(graph[step.Name], dep)
+			}
+		}
+	}
+
+	for _, cycle := range findCycles(wd.Steps, graph) {
+		l.add(RuleDependencyCycle, mappingValue(nodes[index[cycle[0]]], "dependsOn"), "steps form a dependency cycle: %s", strings.Join(cycle, " -> "))
+	}
+}
+
+// findCycles returns each dependency cycle in the step graph once, as a path
+// that starts and ends with the same step. Steps are visited in the order they
+// are defined.
+func findCycles(steps []*v1.WorkflowStep, graph map[string][]string) [][]string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	var (
+		cycles [][]string
+		stack  []string
+		state  = make(map[string]int, len(graph))
+		seen   = make(map[string]bool)
+	)
+
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = visiting
+		stack = append(stack, name)
+
+		for _, dep := range graph[name] {
+			switch state[dep] {
+			case unvisited:
+				visit(dep)
+			case visiting:
+				start := len(stack) - 1
+				for stack[start] != dep {
+					start--
+				}
+
+				cycle := append(append([]string{}, stack[start:]...), dep)
+
+				members := append([]string{}, stack[start:]...)
+				sort.Strings(members)
+
+				if key := strings.Join(members, "\x00"); !seen[key] {
+					seen[key] = true
+					cycles = append(cycles, cycle)
+				}
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[name] = visited
+	}
+
+	for _, step := range steps {
+		if state[step.Name] == unvisited {
+			visit(step.Name)
+		}
+	}
+
+	return cycles
+}
+
+func (l *linter) checkTriggers(wd *v1.WorkflowData) {
+	nodes := sequenceItems(mappingValue(l.root, "triggers"))
+	if len(nodes) != len(wd.Triggers) {
+		return
+	}
+
+	for i, trigger := range wd.Triggers {
+		if trigger.Source == nil {
+			continue
+		}
+
+		schedule, ok := trigger.Source.Variant.(*v1.ScheduleWorkflowTriggerSource)
+		if !ok {
+			continue
+		}
+
+		if _, err := schedule.Next(time.Now()); err != nil {
+			node := mappingValue(mappingValue(nodes[i], "source"), "schedule")
+			l.add(RuleInvalidSchedule, node, "trigger %s has an invalid schedule %q: %s", trigger.Name, schedule.Schedule, err.Error())
+		}
+	}
+}
+
+func (l *linter) checkParameters() {
+	params := mappingValue(l.root, "parameters")
+	if params == nil || params.Kind != yaml.MappingNode {
+		return
+	}
+
+	used := make(map[string]bool)
+
+	for i := 0; i+1 < len(l.root.Content); i += 2 {
+		if l.root.Content[i].Value == "parameters" {
+			continue
+		}
+
+		collectParameterReferences(l.root.Content[i+1], used)
+	}
+
+	for i := 0; i+1 < len(params.Content); i += 2 {
+		key := params.Content[i]
+		if !used[key.Value] {
+			l.add(RuleUnusedParameter, key, "parameter %s is never used", key.Value)
+		}
+	}
+}
+
+// collectParameterReferences finds references to parameters, written as
+// !Parameter tags, as {$type: Parameter} mappings or as ${parameters.name}
+// expressions in strings.
+func collectParameterReferences(node *yaml.Node, used map[string]bool) {
+	if node == nil {
+		return
+	}
+
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if node.Tag == "!Parameter" {
+			used[node.Value] = true
+		} else {
+			for _, name := range templateParameterReferences(node.Value) {
+				used[name] = true
+			}
+		}
+	case yaml.MappingNode:
+		if node.Tag == "!Parameter" || scalarValue(mappingValue(node, "$type")) == "Parameter" {
+			used[scalarValue(mappingValue(node, "name"))] = true
+		}
+	case yaml.AliasNode:
+		collectParameterReferences(node.Alias, used)
+		return
+	}
+
+	for _, child := range node.Content {
+		collectParameterReferences(child, used)
+	}
+}
+
+// templateParameterReferences returns the names of the parameters used by the
+// ${...} expressions in a string.
+func templateParameterReferences(value string) []string {
+	var names []string
+
+	for _, expr := range templatePattern.FindAllStringSubmatch(value, -1) {
+		for _, match := range templateParameterPattern.FindAllStringSubmatch(expr[1], -1) {
+			if match[1] != "" {
+				names = append(names, match[1])
+			} else {
+				names = append(names, match[2])
+			}
+		}
+	}
+
+	return names
+}
+
+func dependencyNode(step *yaml.Node, dep string) *yaml.Node {
+	deps := mappingValue(step, "dependsOn")
+	if deps != nil && deps.Kind == yaml.SequenceNode {
+		for _, item := range deps.Content {
+			if item.Value == dep {
+				return item
+			}
+		}
+	}
+
+	return deps
+}
+
+// lookupPath finds the node at a JSON schema context path such as
+// (root).steps.0.name, returning the deepest node that exists.
+func lookupPath(root *yaml.Node, path string) *yaml.Node {
+	node := root
+
+	for _, part := range strings.Split(path, ".") {
+		if part == "(root)" || part == "" {
+			continue
+		}
+
+		var next *yaml.Node
+
+		switch node.Kind {
+		case yaml.MappingNode:
+			next = mappingValue(node, part)
+		case yaml.SequenceNode:
+			if i, err := strconv.Atoi(part); err == nil && i >= 0 && i < len(node.Content) {
+				next = node.Content[i]
+			}
+		}
+
+		if next == nil {
+			break
+		}
+
+		node = next
+	}
+
+	return node
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+func sequenceItems(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	return node.Content
+}
+
+func scalarValue(node *yaml.Node) string {
+	if node == nil || node.Kind != yaml.ScalarNode {
+		return ""
+	}
+
+	return node.Value
+}
@@ -0,0 +1,126 @@
+package lint
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func lint(t *testing.T, content string) []*Finding {
+	findings, err := Lint(context.Background(), strings.NewReader(content))
+	require.NoError(t, err)
+
+	return findings
+}
+
+func TestLintValid(t *testing.T) {
+	findings := lint(t, `apiVersion: v1
+parameters:
+  message:
+    default: hello
+  target:
+    description: where to deploy
+steps:
+- name: build
+  image: alpine:latest
+  spec:
+    message: !Parameter message
+- name: deploy
+  image: alpine:latest
+  dependsOn: build
+  spec:
+    target: {$type: Parameter, name: target}
+triggers:
+- name: nightly
+  source:
+    type: schedule
+    schedule: '0 0 * * *'
+`)
+
+	require.Empty(t, findings)
+}
+
+func TestLintTemplateParameters(t *testing.T) {
+	findings := lint(t, `apiVersion: v1
+parameters:
+  message:
+    default: hello
+  target:
+    default: prod
+  region:
+    default: us
+  unused:
+    default: nothing
+steps:
+- name: greet
+  image: alpine:latest
+  input:
+  - echo "${parameters.message}"
+  - echo "${parameters['target'] + parameters.region}"
+  - echo "$parameters.unused is not an expression"
+`)
+
+	require.Len(t, findings, 1)
+	require.Equal(t, RuleUnusedParameter.ID, findings[0].Rule)
+	require.Equal(t, "parameter unused is never used", findings[0].Message)
+}
+
+func TestLintFindings(t *testing.T) {
+	findings := lint(t, `apiVersion: v1
+parameters:
+  unused:
+    default: nothing
+steps:
+- name: a
+  image: alpine:latest
+  dependsOn: [c, missing]
+- name: b
+  image: alpine:latest
+  dependsOn: a
+- name: c
+  image: alpine:latest
+  dependsOn: b
+- name: a
+  image: alpine:latest
+triggers:
+- name: broken
+  source:
+    type: schedule
+    schedule: 'every day'
+`)
+
+	type result struct {
+		Rule   string
+		Line   int
+		Column int
+	}
+
+	var results []result
+	for _, f := range findings {
+		results = append(results, result{f.Rule, f.Line, f.Column})
+	}
+
+	require.Equal(t, []result{
+		{RuleUnusedParameter.ID, 3, 3},
+		{RuleDependencyCycle.ID, 8, 14},
+		{RuleUnknownDependency.ID, 8, 18},
+		{RuleDuplicateStepName.ID, 15, 9},
+		{RuleInvalidSchedule.ID, 21, 15},
+	}, results)
+
+	require.Equal(t, "steps form a dependency cycle: a -> c -> b -> a", findings[1].Message)
+	require.Equal(t, SeverityWarning, findings[0].Severity)
+}
+
+func TestLintSyntaxAndSchema(t *testing.T) {
+	findings := lint(t, "steps:\n- name: a\n  image: [\n")
+	require.Len(t, findings, 1)
+	require.Equal(t, RuleSyntax.ID, findings[0].Rule)
+
+	findings = lint(t, "steps:\n- name: a\n  image: alpine:latest\n- image: alpine:latest\n")
+	require.Len(t, findings, 1)
+	require.Equal(t, RuleSchema.ID, findings[0].Rule)
+	require.Equal(t, 4, findings[0].Line)
+}
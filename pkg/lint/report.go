@@ -0,0 +1,154 @@
+package lint
+
+import (
+	"encoding/json"
+	"io"
+	"path/filepath"
+)
+
+// Result holds the findings for a single workflow file.
+type Result struct {
+	File     string
+	Findings []*Finding
+}
+
+type fileFinding struct {
+	File string `json:"file"`
+	*Finding
+}
+
+// WriteJSON writes the findings of every result as a single JSON array, with
+// each finding carrying the file it was found in.
+func WriteJSON(w io.Writer, results []*Result) error {
+	findings := []*fileFinding{}
+
+	for _, result := range results {
+		for _, finding := range result.Findings {
+			findings = append(findings, &fileFinding{File: result.File, Finding: finding})
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(findings)
+}
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+type sarifLog struct {
+	Schema  string      `json:"$schema"`
+	Version string      `json:"version"`
+	Runs    []*sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool      `json:"tool"`
+	Results []*sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string       `json:"name"`
+	InformationURI string       `json:"informationUri"`
+	Version        string       `json:"version,omitempty"`
+	Rules          []*sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string                 `json:"id"`
+	ShortDescription     sarifMessage           `json:"shortDescription"`
+	DefaultConfiguration sarifRuleConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifRuleConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string           `json:"ruleId"`
+	Level     string           `json:"level"`
+	Message   sarifMessage     `json:"message"`
+	Locations []*sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+}
+
+// WriteSARIF writes the findings of every result as a SARIF 2.1.0 log, as
+// consumed by code scanning tools.
+func WriteSARIF(w io.Writer, results []*Result, toolVersion string) error {
+	run := &sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "relay",
+				InformationURI: "https://relay.sh",
+				Version:        toolVersion,
+			},
+		},
+		Results: []*sarifResult{},
+	}
+
+	for _, rule := range Rules {
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, &sarifRule{
+			ID:                   rule.ID,
+			ShortDescription:     sarifMessage{Text: rule.Description},
+			DefaultConfiguration: sarifRuleConfiguration{Level: string(rule.Severity)},
+		})
+	}
+
+	for _, result := range results {
+		for _, finding := range result.Findings {
+			run.Results = append(run.Results, &sarifResult{
+				RuleID:  finding.Rule,
+				Level:   string(finding.Severity),
+				Message: sarifMessage{Text: finding.Message},
+				Locations: []*sarifLocation{
+					{
+						PhysicalLocation: sarifPhysicalLocation{
+							ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(result.File)},
+							Region: sarifRegion{
+								StartLine:   finding.Line,
+								StartColumn: finding.Column,
+							},
+						},
+					},
+				},
+			})
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(&sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []*sarifRun{run},
+	})
+}
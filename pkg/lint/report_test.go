@@ -0,0 +1,42 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteSARIF(t *testing.T) {
+	results := []*Result{
+		{
+			File: "workflows/deploy.yaml",
+			Findings: []*Finding{
+				{Rule: RuleUnknownDependency.ID, Severity: SeverityError, Message: "step a depends on b", Line: 6, Column: 14},
+			},
+		},
+		{File: "workflows/build.yaml"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteSARIF(&buf, results, "1.2.3"))
+
+	var log map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &log))
+	require.Equal(t, "2.1.0", log["version"])
+
+	run := log["runs"].([]interface{})[0].(map[string]interface{})
+	require.Len(t, run["tool"].(map[string]interface{})["driver"].(map[string]interface{})["rules"], len(Rules))
+
+	sarifResults := run["results"].([]interface{})
+	require.Len(t, sarifResults, 1)
+
+	result := sarifResults[0].(map[string]interface{})
+	require.Equal(t, "unknown-dependency", result["ruleId"])
+	require.Equal(t, "error", result["level"])
+
+	location := result["locations"].([]interface{})[0].(map[string]interface{})["physicalLocation"].(map[string]interface{})
+	require.Equal(t, "workflows/deploy.yaml", location["artifactLocation"].(map[string]interface{})["uri"])
+	require.Equal(t, map[string]interface{}{"startLine": 6.0, "startColumn": 14.0}, location["region"])
+}
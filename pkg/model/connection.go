@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+)
+
+type ConnectionIdentifier struct {
+	ID string `json:"id"`
+}
+
+type ConnectionSummary struct {
+	*ConnectionIdentifier
+
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type ConnectionAvailability struct {
+	Status   string     `json:"status"`
+	TestedAt *time.Time `json:"tested_at,omitempty"`
+}
+
+type ConnectionWorkflowSummary struct {
+	Name string `json:"name"`
+}
+
+type Connection struct {
+	*ConnectionSummary
+
+	CreatedAt    *time.Time                   `json:"created_at"`
+	UpdatedAt    *time.Time                   `json:"updated_at"`
+	Availability *ConnectionAvailability      `json:"availability,omitempty"`
+	Workflows    []*ConnectionWorkflowSummary `json:"workflows,omitempty"`
+}
+
+type ConnectionEntity struct {
+	Connection *Connection `json:"connection"`
+}
@@ -1,9 +1,14 @@
 package model
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/puppetlabs/leg/encoding/transfer"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 type RevisionIdentifier struct {
@@ -22,12 +27,97 @@ type Revision struct {
 	Steps      []*WorkflowStep    `json:"steps"`
 	Raw        string             `json:"raw"`
 	CreatedAT  *time.Time         `json:"created_at"`
+
+	// SourceType and SourceData describe where the revision came from. See
+	// RevisionRelaySource and RevisionRepositorySource in the OpenAPI client:
+	// revisions saved in Relay have no defined source data, and revisions
+	// synced from a repository record the repository, path, tracked_ref and
+	// commit they came from.
+	SourceType string                 `json:"source_type,omitempty"`
+	SourceData map[string]interface{} `json:"source_data,omitempty"`
+}
+
+// Author describes what created the revision, as far as its source records
+// it: the repository and commit for revisions synced from a repository, or
+// otherwise the source type.
+func (r *Revision) Author() string {
+	repository, _ := r.SourceData["repository"].(string)
+	commit, _ := r.SourceData["commit"].(string)
+
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+
+	switch {
+	case repository != "" && commit != "":
+		return repository + "@" + commit
+	case repository != "":
+		return repository
+	case r.SourceType != "":
+		return r.SourceType
+	}
+
+	return "unknown"
+}
+
+// DecodeRaw returns the workflow YAML the revision was created from.
+func (r *Revision) DecodeRaw() (string, error) {
+	dec, err := base64.StdEncoding.DecodeString(r.Raw)
+	if err != nil {
+		return "", err
+	}
+
+	return string(dec), nil
+}
+
+// ReferencedSecrets returns the sorted names of the secrets used by the steps
+// and triggers of the revision.
+func (r *Revision) ReferencedSecrets() []string {
+	seen := make(map[string]bool)
+
+	var names []string
+
+	add := func(refs *WorkflowDataReferences) {
+		if refs == nil {
+			// Possibly non-container step type.
+			return
+		}
+
+		for _, secret := range refs.Secrets {
+			if secret != nil && !seen[secret.Name] {
+				seen[secret.Name] = true
+				names = append(names, secret.Name)
+			}
+		}
+	}
+
+	for _, step := range r.Steps {
+		if step != nil {
+			add(step.References)
+		}
+	}
+
+	for _, trigger := range r.Triggers {
+		if trigger != nil {
+			add(trigger.References)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
 }
 
 type RevisionEntity struct {
 	Revision *Revision `json:"revision"`
 }
 
+func (e *RevisionEntity) OutputJSON() {
+	jsonBytes, _ := json.MarshalIndent(e, "", "  ")
+
+	fmt.Println(string(jsonBytes))
+}
+
 type WorkflowParameters map[string]WorkflowParameter
 
 type WorkflowParameter struct {
@@ -35,10 +125,39 @@ type WorkflowParameter struct {
 	Description string      `json:"description,omitempty"`
 }
 
+// Required returns true if the parameter has no default and must be given a
+// value when the workflow is run.
+func (wp WorkflowParameter) Required() bool {
+	return wp.Default == nil
+}
+
+// Check compares run parameter values against the declared parameters,
+// returning the names of values that are not declared and of required
+// parameters that have no value, both sorted.
+func (wp WorkflowParameters) Check(values map[string]interface{}) (unknown, missing []string) {
+	for name := range values {
+		if _, ok := wp[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+
+	for name, param := range wp {
+		if _, ok := values[name]; !ok && param.Required() {
+			missing = append(missing, name)
+		}
+	}
+
+	sort.Strings(unknown)
+	sort.Strings(missing)
+
+	return
+}
+
 type WorkflowTrigger struct {
-	Name    string                  `json:"name"`
-	Source  *WorkflowTriggerSource  `json:"source"`
-	Binding *WorkflowTriggerBinding `json:"binding"`
+	Name       string                  `json:"name"`
+	Source     *WorkflowTriggerSource  `json:"source"`
+	Binding    *WorkflowTriggerBinding `json:"binding"`
+	References *WorkflowDataReferences `json:"references,omitempty"`
 }
 
 type WorkflowTriggerSource struct {
@@ -52,6 +171,39 @@ type PushWorkflowTriggerSource struct {
 	Schema map[string]transfer.JSONInterface `json:"schema"`
 }
 
+// ValidateData checks event data against the schema of the push trigger. Each
+// field of the schema is a JSON schema for the value of the same field in the
+// data. The problems found are returned in a stable order.
+func (p *PushWorkflowTriggerSource) ValidateData(data map[string]interface{}) ([]string, error) {
+	if len(p.Schema) == 0 {
+		return nil, nil
+	}
+
+	properties := make(map[string]interface{}, len(p.Schema))
+	for name, field := range p.Schema {
+		properties[name] = field.Data
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewGoLoader(schema), gojsonschema.NewGoLoader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	for _, re := range result.Errors() {
+		problems = append(problems, re.String())
+	}
+
+	sort.Strings(problems)
+
+	return problems, nil
+}
+
 type ScheduleWorkflowTriggerSource struct {
 	Schedule string `json:"schedule"`
 }
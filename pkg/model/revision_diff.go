@@ -0,0 +1,88 @@
+package model
+
+import "sort"
+
+// RevisionChanges lists the names of items added to and removed from a
+// workflow between two revisions.
+type RevisionChanges struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+}
+
+// Empty returns true if nothing was added or removed.
+func (rc RevisionChanges) Empty() bool {
+	return len(rc.Added) == 0 && len(rc.Removed) == 0
+}
+
+// RevisionDiff summarizes the structural differences between two revisions.
+type RevisionDiff struct {
+	Steps      RevisionChanges `json:"steps"`
+	Triggers   RevisionChanges `json:"triggers"`
+	Parameters RevisionChanges `json:"parameters"`
+}
+
+// Empty returns true if the revisions declare the same steps, triggers and
+// parameters.
+func (rd *RevisionDiff) Empty() bool {
+	return rd.Steps.Empty() && rd.Triggers.Empty() && rd.Parameters.Empty()
+}
+
+// DiffRevisions compares the steps, triggers and parameters declared by two
+// revisions by name.
+func DiffRevisions(from, to *Revision) *RevisionDiff {
+	return &RevisionDiff{
+		Steps:      diffNames(stepNames(from.Steps), stepNames(to.Steps)),
+		Triggers:   diffNames(triggerNames(from.Triggers), triggerNames(to.Triggers)),
+		Parameters: diffNames(parameterNames(from.Parameters), parameterNames(to.Parameters)),
+	}
+}
+
+func stepNames(steps []*WorkflowStep) map[string]bool {
+	names := make(map[string]bool, len(steps))
+	for _, step := range steps {
+		if step != nil {
+			names[step.Name] = true
+		}
+	}
+
+	return names
+}
+
+func triggerNames(triggers []*WorkflowTrigger) map[string]bool {
+	names := make(map[string]bool, len(triggers))
+	for _, trigger := range triggers {
+		if trigger != nil {
+			names[trigger.Name] = true
+		}
+	}
+
+	return names
+}
+
+func parameterNames(params WorkflowParameters) map[string]bool {
+	names := make(map[string]bool, len(params))
+	for name := range params {
+		names[name] = true
+	}
+
+	return names
+}
+
+func diffNames(from, to map[string]bool) (rc RevisionChanges) {
+	for name := range to {
+		if !from[name] {
+			rc.Added = append(rc.Added, name)
+		}
+	}
+
+	for name := range from {
+		if !to[name] {
+			rc.Removed = append(rc.Removed, name)
+		}
+	}
+
+	sort.Strings(rc.Added)
+	sort.Strings(rc.Removed)
+
+	return
+}
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/puppetlabs/leg/encoding/transfer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowParametersCheck(t *testing.T) {
+	params := WorkflowParameters{
+		"environment": {},
+		"replicas":    {Default: 3},
+		"region":      {Default: "us-east-1"},
+		"message":     {},
+	}
+
+	unknown, missing := params.Check(map[string]interface{}{
+		"environment": "production",
+		"replica":     5,
+		"regoin":      "eu-west-1",
+	})
+
+	require.Equal(t, []string{"regoin", "replica"}, unknown)
+	require.Equal(t, []string{"message"}, missing)
+
+	unknown, missing = params.Check(map[string]interface{}{
+		"environment": "production",
+		"message":     "hello",
+	})
+
+	require.Empty(t, unknown)
+	require.Empty(t, missing)
+}
+
+func TestDiffRevisions(t *testing.T) {
+	from := &Revision{
+		Parameters: WorkflowParameters{"environment": {}, "message": {}},
+		Triggers:   []*WorkflowTrigger{{Name: "nightly"}},
+		Steps:      []*WorkflowStep{{Name: "build"}, {Name: "notify"}},
+	}
+
+	to := &Revision{
+		Parameters: WorkflowParameters{"environment": {}, "replicas": {Default: 3}},
+		Triggers:   []*WorkflowTrigger{{Name: "nightly"}, {Name: "push"}},
+		Steps:      []*WorkflowStep{{Name: "build"}, {Name: "deploy"}, {Name: "test"}},
+	}
+
+	diff := DiffRevisions(from, to)
+
+	require.Equal(t, []string{"deploy", "test"}, diff.Steps.Added)
+	require.Equal(t, []string{"notify"}, diff.Steps.Removed)
+	require.Equal(t, []string{"push"}, diff.Triggers.Added)
+	require.Empty(t, diff.Triggers.Removed)
+	require.Equal(t, []string{"replicas"}, diff.Parameters.Added)
+	require.Equal(t, []string{"message"}, diff.Parameters.Removed)
+	require.False(t, diff.Empty())
+
+	require.True(t, DiffRevisions(to, to).Empty())
+}
+
+func TestPushWorkflowTriggerSourceValidateData(t *testing.T) {
+	source := &PushWorkflowTriggerSource{
+		Schema: map[string]transfer.JSONInterface{
+			"branch": {Data: map[string]interface{}{"type": "string"}},
+			"count":  {Data: map[string]interface{}{"type": "integer"}},
+		},
+	}
+
+	problems, err := source.ValidateData(map[string]interface{}{"branch": "main", "count": 2})
+	require.NoError(t, err)
+	require.Empty(t, problems)
+
+	problems, err = source.ValidateData(map[string]interface{}{"branch": 1, "count": "two"})
+	require.NoError(t, err)
+	require.Len(t, problems, 2)
+
+	problems, err = (&PushWorkflowTriggerSource{}).ValidateData(map[string]interface{}{"anything": true})
+	require.NoError(t, err)
+	require.Empty(t, problems)
+}
+
+func TestRevisionAuthor(t *testing.T) {
+	rev := &Revision{SourceType: "repository", SourceData: map[string]interface{}{"repository": "acme/workflows", "path": "deploy.yaml", "tracked_ref": "main", "commit": "0123456789abcdef"}}
+	require.Equal(t, "acme/workflows@0123456", rev.Author())
+
+	rev = &Revision{SourceType: "relay"}
+	require.Equal(t, "relay", rev.Author())
+
+	require.Equal(t, "unknown", (&Revision{}).Author())
+}
+
+func TestRevisionReferencedSecrets(t *testing.T) {
+	var rev Revision
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"steps": [
+			{"name": "deploy", "references": {"secrets": [{"name": "token"}, {"name": "password"}]}},
+			{"name": "approve", "type": "approval"}
+		],
+		"triggers": [
+			{"name": "github", "source": {"type": "webhook"}, "references": {"secrets": [{"name": "webhook-secret"}, {"name": "token"}]}}
+		]
+	}`), &rev))
+
+	require.Equal(t, []string{"password", "token", "webhook-secret"}, rev.ReferencedSecrets())
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type WorkflowRun struct {
+	Revision  RevisionSummary `json:"revision"`
+	RunNumber uint            `json:"run_number"`
+
+	Workflow   *WorkflowIdentifier              `json:"workflow,omitempty"`
+	CreatedAt  *time.Time                       `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time                       `json:"updated_at,omitempty"`
+	CreatedBy  *WorkflowRunCreator              `json:"created_by,omitempty"`
+	State      *WorkflowRunState                `json:"state,omitempty"`
+	Parameters map[string]*WorkflowRunParameter `json:"parameters,omitempty"`
+}
+
+type WorkflowRunEntity struct {
+	Run *WorkflowRun `json:"run"`
+}
+
+func (e *WorkflowRunEntity) OutputJSON() {
+	jsonBytes, _ := json.MarshalIndent(e, "", "  ")
+
+	fmt.Println(string(jsonBytes))
+}
+
+type WorkflowRunParameter struct {
+	Value interface{} `json:"value"`
+}
+
+type WorkflowRunState struct {
+	Status    string                           `json:"status"`
+	StartedAt *time.Time                       `json:"started_at"`
+	EndedAt   *time.Time                       `json:"ended_at"`
+	Steps     map[string]*WorkflowRunStepState `json:"steps,omitempty"`
+}
+
+type WorkflowRunStepState struct {
+	Type      string     `json:"type,omitempty"`
+	Status    string     `json:"status"`
+	StartedAt *time.Time `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at"`
+
+	// Approval steps only
+	Approval            string       `json:"approval,omitempty"`
+	ApprovalSubmittedAt *time.Time   `json:"approval_submitted_at,omitempty"`
+	Approver            *UserSummary `json:"approver,omitempty"`
+}
+
+type WorkflowTriggerIdentifier struct {
+	Name string `json:"name"`
+}
+
+type EventSource struct {
+	Type    string                     `json:"type"`
+	Trigger *WorkflowTriggerIdentifier `json:"trigger,omitempty"`
+}
+
+type EventSummary struct {
+	ID     string       `json:"id"`
+	Source *EventSource `json:"source,omitempty"`
+}
+
+type EventEntity struct {
+	Event *EventSummary `json:"event"`
+}
+
+type WorkflowRunCreator struct {
+	Type  string        `json:"type"`
+	User  *UserSummary  `json:"user,omitempty"`
+	Event *EventSummary `json:"event,omitempty"`
+}
+
+// String describes who or what started a run: the user's name for manual runs
+// or the trigger name for runs started by an event.
+func (c *WorkflowRunCreator) String() string {
+	if c == nil {
+		return ""
+	}
+
+	switch {
+	case c.User != nil && c.User.Name != "":
+		return c.User.Name
+	case c.User != nil:
+		return c.User.Email
+	case c.Event != nil && c.Event.Source != nil && c.Event.Source.Trigger != nil:
+		return fmt.Sprintf("trigger %s", c.Event.Source.Trigger.Name)
+	}
+
+	return c.Type
+}
@@ -1,6 +1,12 @@
 package model
 
-import "fmt"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
 
 type Token string
 
@@ -11,3 +17,40 @@ func (t *Token) Bearer() string {
 func (t Token) String() string {
 	return string(t)
 }
+
+// TokenClaims are the registered claims of a token issued as a JWT.
+type TokenClaims struct {
+	ID        string `json:"jti,omitempty"`
+	Subject   string `json:"sub,omitempty"`
+	ExpiresAt int64  `json:"exp,omitempty"`
+}
+
+// Expires returns when the token expires, if it has an expiry.
+func (tc *TokenClaims) Expires() (time.Time, bool) {
+	if tc.ExpiresAt == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(tc.ExpiresAt, 0), true
+}
+
+// Claims decodes the claims of the token without verifying its signature. It
+// returns false if the token is not a JWT.
+func (t Token) Claims() (*TokenClaims, bool) {
+	parts := strings.Split(string(t), ".")
+	if len(parts) != 3 {
+		return nil, false
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, false
+	}
+
+	claims := &TokenClaims{}
+	if err := json.Unmarshal(b, claims); err != nil {
+		return nil, false
+	}
+
+	return claims, true
+}
@@ -0,0 +1,20 @@
+package model
+
+type UserSummary struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+type UserEntity struct {
+	User *UserSummary `json:"user"`
+}
+
+type AccountSummary struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type AccountEntity struct {
+	Account *AccountSummary `json:"account"`
+}
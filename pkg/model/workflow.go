@@ -32,13 +32,6 @@ type Workflow struct {
 	MostRecentRun  WorkflowRun      `json:"most_recent_run"`
 }
 
-type WorkflowRun struct {
-	Revision  RevisionSummary `json:"revision"`
-	RunNumber uint            `json:"run_number"`
-
-	// TODO: There's a lot of fields here that are missing. Do we want to backfill them?
-}
-
 type WorkflowEntity struct {
 	Workflow *Workflow `json:"workflow"`
 }
@@ -0,0 +1,121 @@
+// Package schedule previews the fire times of workflow schedule triggers
+// without contacting Relay.
+package schedule
+
+import (
+	"time"
+
+	v1 "github.com/puppetlabs/relay-client-go/models/pkg/workflow/types/v1"
+	"github.com/puppetlabs/relay/pkg/model"
+	"gopkg.in/yaml.v3"
+)
+
+// intervalSamples is the number of fire times used to find the shortest
+// interval of a schedule, so that schedules firing in bursts are noticed even
+// when only a few fire times are previewed.
+const intervalSamples = 100
+
+// Trigger is a schedule trigger of a workflow.
+type Trigger struct {
+	Name     string
+	Schedule string
+}
+
+// Preview holds the upcoming fire times of a schedule trigger.
+type Preview struct {
+	Trigger *Trigger
+	Times   []time.Time
+
+	// MinInterval is the shortest time between two consecutive fire times.
+	MinInterval time.Duration
+}
+
+// FromRevision returns the schedule triggers of a workflow revision.
+func FromRevision(rev *model.Revision) []*Trigger {
+	var triggers []*Trigger
+
+	for _, trigger := range rev.Triggers {
+		if trigger == nil || trigger.Source == nil || trigger.Source.Type != v1.WorkflowTriggerSourceTypeSchedule.String() {
+			continue
+		}
+
+		triggers = append(triggers, &Trigger{
+			Name:     trigger.Name,
+			Schedule: trigger.Source.Schedule,
+		})
+	}
+
+	return triggers
+}
+
+// FromFile returns the schedule triggers of a local workflow file.
+func FromFile(content []byte) ([]*Trigger, error) {
+	var wf struct {
+		Triggers []struct {
+			Name   string `yaml:"name"`
+			Source struct {
+				Type     string `yaml:"type"`
+				Schedule string `yaml:"schedule"`
+			} `yaml:"source"`
+		} `yaml:"triggers"`
+	}
+
+	if err := yaml.Unmarshal(content, &wf); err != nil {
+		return nil, err
+	}
+
+	var triggers []*Trigger
+
+	for _, trigger := range wf.Triggers {
+		if trigger.Source.Type != v1.WorkflowTriggerSourceTypeSchedule.String() {
+			continue
+		}
+
+		triggers = append(triggers, &Trigger{
+			Name:     trigger.Name,
+			Schedule: trigger.Source.Schedule,
+		})
+	}
+
+	return triggers, nil
+}
+
+// Next returns the next n fire times of a schedule trigger after from. Relay
+// evaluates schedules in UTC, so the times are computed in UTC and then
+// converted to loc.
+func Next(trigger *Trigger, from time.Time, n int, loc *time.Location) (*Preview, error) {
+	source := &v1.ScheduleWorkflowTriggerSource{Schedule: trigger.Schedule}
+
+	samples := n
+	if samples < intervalSamples {
+		samples = intervalSamples
+	}
+
+	preview := &Preview{Trigger: trigger}
+
+	prev := from.UTC()
+
+	for i := 0; i < samples; i++ {
+		next, err := source.Next(prev)
+		if err != nil {
+			return nil, err
+		} else if next.IsZero() {
+			// The schedule never fires again, for example on February 30.
+			break
+		}
+
+		if i > 0 {
+			if interval := next.Sub(prev); preview.MinInterval == 0 || interval < preview.MinInterval {
+				preview.MinInterval = interval
+			}
+		}
+
+		if i < n {
+			preview.Times = append(preview.Times, next.In(loc))
+		}
+
+		prev = next
+	}
+
+	return preview, nil
+}
@@ -0,0 +1,50 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNext(t *testing.T) {
+	from := time.Date(2021, time.March, 1, 12, 30, 0, 0, time.UTC)
+
+	daily, err := Next(&Trigger{Name: "daily", Schedule: "0 0 * * *"}, from, 2, time.UTC)
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{
+		time.Date(2021, time.March, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.March, 3, 0, 0, 0, 0, time.UTC),
+	}, daily.Times)
+	require.Equal(t, 24*time.Hour, daily.MinInterval)
+
+	hourly, err := Next(&Trigger{Name: "hourly", Schedule: "0 * * * *"}, from, 1, time.UTC)
+	require.NoError(t, err)
+	require.Len(t, hourly.Times, 1)
+	require.Equal(t, time.Hour, hourly.MinInterval)
+
+	loc := time.FixedZone("UTC+2", 2*60*60)
+
+	zoned, err := Next(&Trigger{Name: "daily", Schedule: "0 0 * * *"}, from, 1, loc)
+	require.NoError(t, err)
+	require.Equal(t, "2021-03-02T02:00:00+02:00", zoned.Times[0].Format(time.RFC3339))
+
+	_, err = Next(&Trigger{Name: "typo", Schedule: "0 0 * *"}, from, 1, time.UTC)
+	require.Error(t, err)
+}
+
+func TestFromFile(t *testing.T) {
+	triggers, err := FromFile([]byte(`
+triggers:
+- name: nightly
+  source:
+    type: schedule
+    schedule: 0 0 * * *
+- name: hook
+  source:
+    type: webhook
+    image: relaysh/core
+`))
+	require.NoError(t, err)
+	require.Equal(t, []*Trigger{{Name: "nightly", Schedule: "0 0 * * *"}}, triggers)
+}